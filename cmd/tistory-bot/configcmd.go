@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Song-wh/tistory-bot/internal/collector"
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// collectorRegistry 설정 검증에 쓰는 수집기 레지스트리
+var collectorRegistry = config.Registry{
+	HasSource:   collector.Has,
+	HasCategory: collector.EmitsCategory,
+}
+
+// config 명령어 - 설정 파일 도구
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "설정 파일 도구 ⚙️",
+}
+
+// config validate 명령어
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "설정 파일 검증 (오타, cron, 카테고리, 중복 계정)",
+	Long: `설정 파일을 읽어 문제를 줄 번호와 함께 모두 보여줍니다.
+
+  - 알 수 없는 필드 (오타)
+  - 잘못된 cron 표현식 / 기간 값 / humanize 규칙
+  - 등록되지 않은 스케줄 카테고리
+  - 어떤 수집기도 만들지 않는 categories 매핑 키
+  - 중복된 계정 이름 / 블로그 이름
+
+모든 명령어는 설정을 읽을 때 같은 검증을 거치며, 문제가 있으면 실행하지 않습니다.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := loadConfig()
+		if err != nil {
+			var verr *config.ValidationError
+			if !errors.As(err, &verr) {
+				fmt.Printf("❌ 설정 로드 실패: %v\n", err)
+				exit(1)
+			}
+			fmt.Printf("❌ %s: 문제 %d건\n", verr.File, len(verr.Issues))
+			for _, is := range verr.Issues {
+				loc := verr.File
+				if is.Line > 0 {
+					loc = fmt.Sprintf("%s:%d", verr.File, is.Line)
+				}
+				if is.Path != "" {
+					fmt.Printf("  • %s  %s: %s\n", loc, is.Path, is.Message)
+				} else {
+					fmt.Printf("  • %s  %s\n", loc, is.Message)
+				}
+			}
+			exit(1)
+		}
+
+		jobs := 0
+		for _, acc := range cfg.Accounts {
+			jobs += len(acc.Schedule.Jobs)
+		}
+		fmt.Printf("✅ %s: 문제 없음 (계정 %d개, 활성 %d개, 스케줄 작업 %d개)\n",
+			cfgFile, len(cfg.Accounts), len(cfg.GetEnabledAccounts()), jobs)
+	},
+}
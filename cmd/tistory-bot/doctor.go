@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/Song-wh/tistory-bot/internal/tistory"
+	"github.com/spf13/cobra"
+)
+
+var doctorProfile string // doctor editor --profile: 설정 대신 확인할 프로필 파일
+
+// doctor 명령어 - 환경 점검
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "동작 환경 점검 🩺",
+	Long: `봇 동작에 필요한 환경을 점검합니다.
+
+하위 명령어:
+  editor - 에디터를 열어 셀렉터 프로필의 각 액션이 찾아지는지 확인 (발행하지 않음)`,
+}
+
+// doctor editor 명령어
+var doctorEditorCmd = &cobra.Command{
+	Use:   "editor",
+	Short: "에디터 셀렉터 프로필 점검",
+	Long: `새 글 에디터와 발행 레이어를 열어 셀렉터 프로필의 액션별로
+어떤 대체 전략이 일치하는지 보고합니다. 글은 발행하지 않습니다.
+
+티스토리 에디터가 바뀌면 내장 프로필(internal/tistory/profiles/default.yaml)을 복사해
+수정한 뒤 --profile 로 확인하고, config.yaml 의 browser.selector_profile 에 지정하세요.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := loadConfig()
+		if err != nil {
+			fmt.Printf("설정 로드 실패: %v\n", err)
+			exit(1)
+		}
+		if doctorProfile != "" {
+			profile, err := tistory.LoadProfile(doctorProfile)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				exit(1)
+			}
+			deps := currentDeps()
+			deps.profile = profile
+			setDeps(deps)
+		}
+
+		accounts := getTargetAccounts(cfg)
+		if len(accounts) == 0 {
+			fmt.Println("❌ 활성화된 계정이 없습니다.")
+			exit(1)
+		}
+
+		profile := currentDeps().profile
+		if profile == nil {
+			profile = tistory.DefaultProfile()
+		}
+		fmt.Printf("🩺 셀렉터 프로필: %s\n", profile.Describe())
+
+		failed := 0
+		ctx := cmd.Context()
+		for _, acc := range accounts {
+			fmt.Printf("\n🔍 [%s] 에디터 점검 중...\n", acc.Name)
+
+			client := newAccountClient(cfg, &acc)
+			results, err := client.DiagnoseEditor(ctx)
+			client.Close()
+			if err != nil {
+				fmt.Printf("  ❌ [%s] 점검 실패: %v\n", acc.Name, err)
+				failed++
+				continue
+			}
+
+			fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+			for _, r := range results {
+				if r.Matched < 0 {
+					fmt.Printf("  ❌ %-18s 일치 없음 (전략 %d개)\n", r.Action, r.Total)
+					failed++
+					continue
+				}
+				mark := "✅"
+				if r.Matched > 0 {
+					mark = "⚠️" // 대체 전략으로 찾음
+				}
+				fmt.Printf("  %s %-18s [%d/%d] %s\n", mark, r.Action, r.Matched+1, r.Total, r.Strategy)
+			}
+			fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		}
+
+		if failed > 0 {
+			fmt.Printf("\n❌ 찾지 못한 항목 %d개 (⚠️ 는 대체 전략으로 찾은 항목)\n", failed)
+			exit(1)
+		}
+		fmt.Println("\n✅ 모든 액션 확인 완료 (⚠️ 는 대체 전략으로 찾은 항목)")
+	},
+}
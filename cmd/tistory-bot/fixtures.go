@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/collector"
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// fixtures 명령 플래그
+var (
+	fixturesDir    string
+	fixturesUpdate bool
+)
+
+// fixtures 명령어 - 수집기 녹화/재생 골든 파일 관리
+var fixturesCmd = &cobra.Command{
+	Use:   "fixtures",
+	Short: "수집기 응답 녹화/골든 파일 비교 🎞️",
+	Long: `수집기의 실제 HTTP 응답을 녹화해 두고, 네트워크 없이 재생해
+Generate*Post 결과를 골든 파일과 비교합니다.
+
+testdata/{카테고리}/ 구조:
+  http/{host}/*.json  녹화된 응답 (API 키 등 쿼리 값은 REDACTED)
+  clock               녹화 시각 (재생 시 수집기 현재 시각으로 고정)
+  golden.html         기대하는 포스트 출력
+
+하위 명령어:
+  record [category...]   - 실제 API를 호출해 응답과 골든 파일 저장
+  check [category...]    - 녹화 응답으로 재생해 골든 파일과 비교 (생략시 녹화된 전체)`,
+}
+
+// fixtures record 명령어
+var fixturesRecordCmd = &cobra.Command{
+	Use:   "record [category...]",
+	Short: "실제 응답 녹화 + 골든 파일 저장",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// API 키는 설정에서 읽되 녹화 파일에는 남기지 않음
+		cfg, _ := loadConfig()
+
+		failed := 0
+		for _, category := range args {
+			if err := recordFixture(cmd.Context(), cfg, category); err != nil {
+				fmt.Printf("❌ [%s] %v\n", category, err)
+				failed++
+			}
+		}
+		if failed > 0 {
+			exit(1)
+		}
+	},
+}
+
+// fixtures check 명령어
+var fixturesCheckCmd = &cobra.Command{
+	Use:   "check [category...]",
+	Short: "녹화 응답으로 재생해 골든 파일 비교",
+	Run: func(cmd *cobra.Command, args []string) {
+		categories := args
+		if len(categories) == 0 {
+			categories = recordedCategories()
+			if len(categories) == 0 {
+				fmt.Printf("⚠️ 녹화된 카테고리가 없습니다: %s\n", fixturesDir)
+				return
+			}
+		}
+
+		failed := 0
+		for _, category := range categories {
+			if err := checkFixture(cmd.Context(), category); err != nil {
+				fmt.Printf("❌ [%s] %v\n", category, err)
+				failed++
+				continue
+			}
+		}
+		if failed > 0 {
+			fmt.Printf("\n❌ %d/%d 카테고리 불일치 (의도한 변경이면 --update)\n", failed, len(categories))
+			exit(1)
+		}
+		fmt.Printf("\n✅ %d개 카테고리 골든 파일 일치\n", len(categories))
+	},
+}
+
+// fixtureEnv 녹화/재생 공통 수집 환경 (고정 계정, 쿠팡 ID)
+func fixtureEnv(cfg *config.Config) *collector.Env {
+	fixed := &config.Config{}
+	if cfg != nil {
+		fixed.TMDB = cfg.TMDB
+		fixed.FootballData = cfg.FootballData
+	}
+	if fixed.TMDB.APIKey == "" {
+		fixed.TMDB.APIKey = "fixture" // 재생 시 쿼리는 무시됨
+	}
+	return &collector.Env{
+		Config: fixed,
+		Account: &config.AccountConfig{
+			Name:    "fixture",
+			Coupang: config.CoupangConfig{PartnerID: "AF0000000"},
+		},
+	}
+}
+
+// recordFixture 실제 API 응답 녹화 후 골든 파일 저장
+func recordFixture(ctx context.Context, cfg *config.Config, category string) error {
+	src, ok := collector.Lookup(category)
+	if !ok {
+		return fmt.Errorf("알 수 없는 카테고리: %s", category)
+	}
+	if src.Meta().Browser {
+		return fmt.Errorf("브라우저 수집기는 HTTP 녹화/재생 불가 (go test ./internal/collector로 확인): %s", category)
+	}
+
+	dir := filepath.Join(fixturesDir, category)
+	if err := os.RemoveAll(filepath.Join(dir, "http")); err != nil {
+		return fmt.Errorf("기존 녹화 삭제 실패: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("녹화 디렉토리 생성 실패: %w", err)
+	}
+
+	now := time.Now().Truncate(time.Second)
+	collector.SetClock(func() time.Time { return now })
+	defer collector.SetClock(nil)
+
+	fmt.Printf("🎞️ [%s] 응답 녹화 중...\n", category)
+	env := fixtureEnv(cfg)
+	recorder := &collector.RecordDoer{Dir: filepath.Join(dir, "http"), Next: env.Doer()}
+	env.HTTP = recorder
+
+	post, err := src.Collect(ctx, env)
+	if err != nil {
+		return fmt.Errorf("수집 실패: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "clock"), []byte(now.Format(time.RFC3339)+"\n"), 0644); err != nil {
+		return fmt.Errorf("시각 저장 실패: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "golden.html"), []byte(collector.RenderGolden(post)), 0644); err != nil {
+		return fmt.Errorf("골든 파일 저장 실패: %w", err)
+	}
+	fmt.Printf("✅ [%s] 응답 %d건 녹화, 골든 파일 저장: %s\n", category, recorder.Count(), dir)
+	return nil
+}
+
+// checkFixture 녹화 응답으로 재생한 결과와 골든 파일 비교
+func checkFixture(ctx context.Context, category string) error {
+	src, ok := collector.Lookup(category)
+	if !ok {
+		return fmt.Errorf("알 수 없는 카테고리: %s", category)
+	}
+	if src.Meta().Browser {
+		return fmt.Errorf("브라우저 수집기는 HTTP 녹화/재생 불가 (go test ./internal/collector로 확인): %s", category)
+	}
+
+	dir := filepath.Join(fixturesDir, category)
+	data, err := os.ReadFile(filepath.Join(dir, "clock"))
+	if err != nil {
+		return fmt.Errorf("녹화 없음 (fixtures record %s 먼저 실행): %w", category, err)
+	}
+	now, err := time.Parse(time.RFC3339, strings.TrimSpace(string(data)))
+	if err != nil {
+		return fmt.Errorf("녹화 시각 파싱 실패: %w", err)
+	}
+	collector.SetClock(func() time.Time { return now })
+	defer collector.SetClock(nil)
+
+	env := fixtureEnv(nil)
+	env.HTTP = &collector.ReplayDoer{Dir: filepath.Join(dir, "http")}
+
+	post, err := src.Collect(ctx, env)
+	if err != nil {
+		return fmt.Errorf("재생 수집 실패: %w", err)
+	}
+	got := collector.RenderGolden(post)
+
+	goldenPath := filepath.Join(dir, "golden.html")
+	if fixturesUpdate {
+		if err := os.WriteFile(goldenPath, []byte(got), 0644); err != nil {
+			return fmt.Errorf("골든 파일 갱신 실패: %w", err)
+		}
+		fmt.Printf("📝 [%s] 골든 파일 갱신\n", category)
+		return nil
+	}
+
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		return fmt.Errorf("골든 파일 읽기 실패: %w", err)
+	}
+	if line, ok := firstDiff(string(want), got); !ok {
+		return fmt.Errorf("골든 파일 불일치\n%s", line)
+	}
+	fmt.Printf("✅ [%s] 일치\n", category)
+	return nil
+}
+
+// recordedCategories fixturesDir에 녹화가 있는 등록 카테고리 (등록 순서, 브라우저 수집기 제외)
+func recordedCategories() []string {
+	var categories []string
+	for _, src := range collector.Sources() {
+		name := src.Name()
+		if src.Meta().Browser {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(fixturesDir, name, "clock")); err == nil {
+			categories = append(categories, name)
+		}
+	}
+	return categories
+}
+
+// firstDiff 처음으로 다른 줄 설명 (같으면 ok)
+func firstDiff(want, got string) (string, bool) {
+	if want == got {
+		return "", true
+	}
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("  %d행\n  - %s\n  + %s", i+1, w, g), false
+		}
+	}
+	return "", false
+}
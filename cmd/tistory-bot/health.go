@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/metrics"
+	"github.com/Song-wh/tistory-bot/internal/queue"
+)
+
+// pingTimeout 헬스 체크에서 계정 브라우저 응답을 기다리는 시간
+const pingTimeout = 2 * time.Second
+
+var metricsAddr string // schedule --metrics-addr: /healthz, /metrics 주소 (빈 값 = 끔)
+
+// observePost 포스팅 결과 지표 기록 (성공이면 계정의 마지막 성공 시각 갱신)
+func observePost(account, category, result string) {
+	metrics.Posts.Inc(account, category, result)
+	if result != metrics.ResultFailed {
+		metrics.LastSuccess.Set(float64(time.Now().Unix()), account)
+	}
+}
+
+// accountHealth /healthz 계정별 상태
+type accountHealth struct {
+	Account     string     `json:"account"`
+	Browser     bool       `json:"browser"`   // 브라우저가 응답함
+	LoggedIn    bool       `json:"logged_in"` // 세션이 로그인 상태
+	Error       string     `json:"error,omitempty"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`     // 마지막 포스팅 성공 (스케줄러 시작 후)
+	LastAge     string     `json:"last_success_age,omitempty"` // 마지막 성공 후 지난 시간
+}
+
+// healthReport /healthz 응답
+type healthReport struct {
+	Status    string          `json:"status"` // ok | unhealthy (브라우저가 죽었거나 로그아웃된 계정이 있음)
+	StartedAt time.Time       `json:"started_at"`
+	Queue     map[string]int  `json:"queue"` // 상태별 작업 수 (pending, running)
+	Accounts  []accountHealth `json:"accounts"`
+}
+
+// checkHealth 활성 계정의 브라우저/세션 상태와 큐 깊이 확인 (지표 게이지도 함께 갱신)
+func checkHealth(ctx context.Context, live *liveConfig, q *queue.Queue, startedAt time.Time) healthReport {
+	report := healthReport{Status: "ok", StartedAt: startedAt, Queue: queueDepth(q)}
+	metrics.BrowserUp.Reset()
+	metrics.LoggedIn.Reset()
+
+	now := time.Now()
+	for _, acc := range live.Get().GetEnabledAccounts() {
+		h := accountHealth{Account: acc.Name}
+		if client, ok := clients.Get(acc.Name); ok {
+			pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+			if err := client.Ping(pingCtx); err != nil {
+				h.Error = err.Error()
+			} else {
+				h.Browser = true
+			}
+			cancel()
+			h.LoggedIn = client.LoggedIn()
+		} else {
+			h.Error = "브라우저 없음 (로그인 실패 또는 재시작 중)"
+		}
+		if ts, ok := metrics.LastSuccess.Value(acc.Name); ok {
+			last := time.Unix(int64(ts), 0)
+			h.LastSuccess = &last
+			h.LastAge = now.Sub(last).Truncate(time.Second).String()
+		}
+		if !h.Browser || !h.LoggedIn {
+			report.Status = "unhealthy"
+		}
+		metrics.BrowserUp.Set(boolValue(h.Browser), acc.Name)
+		metrics.LoggedIn.Set(boolValue(h.LoggedIn), acc.Name)
+		report.Accounts = append(report.Accounts, h)
+	}
+	return report
+}
+
+// queueDepth 대기/실행 중 작업 수 (지표 게이지도 함께 갱신)
+func queueDepth(q *queue.Queue) map[string]int {
+	depth := map[string]int{string(queue.StatusPending): 0, string(queue.StatusRunning): 0}
+	for _, job := range q.List() {
+		if _, ok := depth[string(job.Status)]; ok {
+			depth[string(job.Status)]++
+		}
+	}
+	for status, n := range depth {
+		metrics.QueueJobs.Set(float64(n), status)
+	}
+	return depth
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// serveHealth addr에서 /healthz(JSON, 비정상이면 503)와 /metrics(Prometheus) 제공 (ctx가 끝나면 종료)
+func serveHealth(ctx context.Context, addr string, live *liveConfig, q *queue.Queue) error {
+	log := logging.From(ctx)
+	startedAt := time.Now()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		report := checkHealth(r.Context(), live, q, startedAt)
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if report.Status != "ok" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.Encode(report)
+	})
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		checkHealth(r.Context(), live, q, startedAt) // 브라우저/세션/큐 게이지 갱신
+		metrics.Default.ServeHTTP(w, r)
+	})
+
+	// 주소 오류(포트 사용 중 등)는 스케줄러 시작 전에 알 수 있도록 먼저 연다
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+	go func() {
+		if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("❌ 헬스 체크 서버 오류", "err", err)
+		}
+	}()
+	log.Info("🩺 헬스 체크/지표 서버", "healthz", "http://"+l.Addr().String()+"/healthz", "metrics", "http://"+l.Addr().String()+"/metrics")
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/collector"
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/history"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/tistory"
+)
+
+// 발행 이력 저장소 (프로세스당 1개, 스케줄러 고루틴 간 공유, history.dir 변경은 재시작 후 적용)
+var (
+	historyOnce  sync.Once
+	historyStore *history.Store
+)
+
+// getHistoryStore 발행 이력 저장소 반환 (비활성화면 nil)
+func getHistoryStore(cfg *config.Config) *history.Store {
+	if cfg.History == nil || !cfg.History.Enabled {
+		return nil
+	}
+	historyOnce.Do(func() {
+		historyStore = history.NewStore(cfg.History.Dir)
+	})
+	return historyStore
+}
+
+// guardDuplicate 최근 발행 이력과 소스 항목이 겹치면 재생성하거나 건너뜀 (nil = 건너뜀)
+// 재생성은 처음 수집한 env(HTTP 클라이언트/BaseURL 포함)에 제외 항목만 더해 다시 수집한다.
+func guardDuplicate(ctx context.Context, env *collector.Env, category string, post *collector.Post) *collector.Post {
+	cfg, acc := env.Config, env.Account
+	store := getHistoryStore(cfg)
+	if store == nil || post == nil {
+		return post
+	}
+
+	log := logging.From(ctx)
+	since := time.Now().Add(-time.Duration(cfg.History.WindowHours) * time.Hour)
+	records, err := store.Recent(acc.Name, category, since)
+	if err != nil {
+		log.Warn("⚠️ 발행 이력 조회 실패, 중복 검사 생략", "err", err)
+		return post
+	}
+
+	match := history.FindOverlap(records, history.ContentHash(post.Content), post.SourceIDs)
+	maxOverlap := *cfg.History.MaxOverlap
+	if match == nil || match.Overlap <= maxOverlap {
+		return post
+	}
+
+	log.Warn("⚠️ 최근 포스트와 중복",
+		"overlap_pct", math.Round(match.Overlap*100), "title", match.Record.Title,
+		"account", acc.Name, "published_at", match.Record.PublishedAt.Format("01/02 15:04"))
+
+	if cfg.History.Action != config.HistoryActionRegenerate {
+		log.Info("⏭️ 중복 포스트, 건너뜀")
+		return nil
+	}
+
+	// 최근 발행된 항목을 제외하고 다시 수집
+	log.Info("🔄 중복 항목 제외 후 재생성...")
+	src, _ := collector.Lookup(category)
+	regenEnv := *env
+	regenEnv.Exclude = history.SeenIDs(records)
+	regenerated, err := src.Collect(ctx, &regenEnv)
+	if err != nil {
+		log.Error("❌ 재생성 실패", "err", err)
+		return nil
+	}
+	if regenerated == nil {
+		log.Info("⏭️ 재생성할 새 항목 없음, 건너뜀")
+		return nil
+	}
+	if regenerated.CreatedAt.IsZero() {
+		regenerated.CreatedAt = time.Now()
+	}
+
+	match = history.FindOverlap(records, history.ContentHash(regenerated.Content), regenerated.SourceIDs)
+	if match != nil && match.Overlap > maxOverlap {
+		log.Info("⏭️ 재생성 후에도 중복, 건너뜀", "overlap_pct", math.Round(match.Overlap*100), "title", match.Record.Title)
+		return nil
+	}
+	return regenerated
+}
+
+// recordHistory 발행 완료된 포스트를 이력에 기록 (임시저장은 아직 발행 전이므로 기록하지 않음)
+func recordHistory(ctx context.Context, cfg *config.Config, acc *config.AccountConfig, category string, post *collector.Post, result *tistory.PostResult) {
+	store := getHistoryStore(cfg)
+	if store == nil || post == nil || (result != nil && result.Draft) {
+		return
+	}
+
+	rec := history.Record{
+		Account:     acc.Name,
+		Category:    category,
+		Title:       post.Title,
+		ContentHash: history.ContentHash(post.Content),
+		SourceIDs:   post.SourceIDs,
+		CreatedAt:   post.CreatedAt,
+		PublishedAt: time.Now(),
+	}
+	if result != nil {
+		rec.PostID = result.PostID
+		rec.URL = result.URL
+		if !result.PublishedAt.IsZero() {
+			rec.PublishedAt = result.PublishedAt
+		}
+	}
+	if err := store.Add(rec); err != nil {
+		logging.From(ctx).Warn("⚠️ 발행 이력 저장 실패", "err", err)
+	}
+}
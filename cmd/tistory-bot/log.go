@@ -0,0 +1,46 @@
+package main
+
+import (
+	"io"
+	"os"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+)
+
+var (
+	logLevel  string // --log-level: log.level 덮어쓰기
+	logFormat string // --log-format: log.format 덮어쓰기
+
+	logFile io.Closer // 설정으로 연 로그 파일 (한 번만 구성, 설정을 다시 읽어도 그대로)
+)
+
+// setupLogging 설정의 log 섹션과 명령행 옵션으로 기본 로거 구성 (처음 한 번만)
+func setupLogging(cfg *config.Config) error {
+	if logFile != nil {
+		return nil
+	}
+	lc := config.LogConfig{}
+	if cfg != nil && cfg.Log != nil {
+		lc = *cfg.Log
+	}
+	if logLevel != "" {
+		lc.Level = logLevel
+	}
+	if logFormat != "" {
+		lc.Format = logFormat
+	}
+	closer, err := logging.Setup(&lc, os.Stdout)
+	if err != nil {
+		return err
+	}
+	logFile = closer
+	return nil
+}
+
+// closeLog 로그 파일 닫기
+func closeLog() {
+	if logFile != nil {
+		logFile.Close()
+	}
+}
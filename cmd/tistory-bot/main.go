@@ -3,26 +3,102 @@ package main
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/Song-wh/tistory-bot/internal/analytics"
 	"github.com/Song-wh/tistory-bot/internal/collector"
 	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/media"
+	"github.com/Song-wh/tistory-bot/internal/metrics"
+	"github.com/Song-wh/tistory-bot/internal/queue"
+	"github.com/Song-wh/tistory-bot/internal/runs"
+	"github.com/Song-wh/tistory-bot/internal/secrets"
+	"github.com/Song-wh/tistory-bot/internal/theme"
 	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 	"github.com/Song-wh/tistory-bot/internal/tistory"
-	"github.com/robfig/cron/v3"
 	"github.com/spf13/cobra"
 )
 
 var cfgFile string
 var accountName string // 특정 계정만 실행할 때 사용
 
-// 전역 클라이언트 맵 (브라우저 재사용)
-var clientMap = make(map[string]*tistory.Client)
+// clientDeps 설정에서 만든 브라우저 클라이언트 공용 값 (설정을 다시 읽으면 통째로 교체)
+type clientDeps struct {
+	profile *tistory.Profile // browser.selector_profile (nil = 내장 기본값)
+	images  *media.Cache     // images 캐시 (nil = 본문 이미지 주소를 그대로 둠)
+}
+
+// 현재 클라이언트 공용 값 (스케줄러 워커와 설정 다시 읽기가 함께 접근)
+var (
+	depsMu  sync.RWMutex
+	curDeps clientDeps
+)
+
+// currentDeps 현재 클라이언트 공용 값
+func currentDeps() clientDeps {
+	depsMu.RLock()
+	defer depsMu.RUnlock()
+	return curDeps
+}
+
+// setDeps 클라이언트 공용 값 교체
+func setDeps(deps clientDeps) {
+	depsMu.Lock()
+	defer depsMu.Unlock()
+	curDeps = deps
+}
+
+// 스케줄러에서 미리 로그인한 계정별 브라우저 클라이언트 (재사용)
+var clients = &clientRegistry{m: map[string]*tistory.Client{}}
+
+// clientRegistry 계정 이름 → 클라이언트 (계정 워커 고루틴 간 공유)
+type clientRegistry struct {
+	mu sync.Mutex
+	m  map[string]*tistory.Client
+}
+
+// Get 계정의 클라이언트 조회
+func (r *clientRegistry) Get(name string) (*tistory.Client, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.m[name]
+	return c, ok
+}
+
+// Set 계정의 클라이언트 등록
+func (r *clientRegistry) Set(name string, c *tistory.Client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.m[name] = c
+}
+
+// Remove 계정의 클라이언트 등록 해제 (닫지 않음)
+func (r *clientRegistry) Remove(name string) (*tistory.Client, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.m[name]
+	delete(r.m, name)
+	return c, ok
+}
+
+// CloseAll 모든 클라이언트 종료 (계정마다 종료 결과로 done 호출)
+func (r *clientRegistry) CloseAll(done func(name string, err error)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, c := range r.m {
+		err := c.Close()
+		delete(r.m, name)
+		if done != nil {
+			done(name, err)
+		}
+	}
+}
 
 var rootCmd = &cobra.Command{
 	Use:   "tistory-bot",
@@ -49,20 +125,22 @@ var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "티스토리 로그인 테스트",
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
+		cfg, err := loadConfig()
 		if err != nil {
 			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		accounts := getTargetAccounts(cfg)
 		if len(accounts) == 0 {
 			fmt.Println("❌ 활성화된 계정이 없습니다.")
-			os.Exit(1)
+			exit(1)
 		}
 
 		for _, acc := range accounts {
-			fmt.Printf("\n🔑 [%s] 로그인 테스트 중...\n", acc.Name)
+			ctx := logging.With(cmd.Context(), logging.KeyAccount, acc.Name)
+			log := logging.From(ctx)
+			log.Info("🔑 로그인 테스트 중...")
 
 			client := tistory.NewClient(
 				acc.Tistory.Email,
@@ -72,13 +150,12 @@ var loginCmd = &cobra.Command{
 				500,
 			)
 
-			ctx := context.Background()
 			if err := client.TestLogin(ctx); err != nil {
-				fmt.Printf("❌ [%s] 로그인 실패: %v\n", acc.Name, err)
+				log.Error("❌ 로그인 실패", "err", err)
 				continue
 			}
 
-			fmt.Printf("✅ [%s] 로그인 성공!\n", acc.Name)
+			log.Info("✅ 로그인 성공!")
 		}
 	},
 }
@@ -87,105 +164,53 @@ var loginCmd = &cobra.Command{
 var postCmd = &cobra.Command{
 	Use:   "post [category]",
 	Short: "글 작성 (모든 계정 또는 특정 계정)",
-	Long: `지정한 카테고리의 글을 자동으로 작성합니다.
-
---account [name] 옵션으로 특정 계정만 포스팅 가능
-
-카테고리:
-  crypto       - 코인 시세 정보
-  deals        - 핫딜/할인 정보
-  tech         - IT/테크 뉴스
-  movie        - 영화/드라마 정보
-  trend        - 트렌드/실검
-  lotto        - 로또 당첨번호
-  lotto-predict - 로또 예측번호 (AI 분석)
-  fortune      - 오늘의 운세
-  sports       - 스포츠 뉴스
-  coupang      - 쿠팡 특가/파트너스 💰
-  golf         - 내일 골프 날씨 예보 ⛳
-  golf-tips    - 골프 레슨 팁 + 용품 추천 🏌️
-  error        - 에러/장애 해결 아카이브 🔴`,
-	Args: cobra.ExactArgs(1),
+	Args:  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
+		cfg, err := loadConfig()
 		if err != nil {
 			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		accounts := getTargetAccounts(cfg)
 		if len(accounts) == 0 {
 			fmt.Println("❌ 활성화된 계정이 없습니다.")
-			os.Exit(1)
+			exit(1)
 		}
 
 		category := args[0]
-		ctx := context.Background()
+		ctx := cmd.Context()
+
+		opts, err := publishOptionsFromFlags()
+		if err != nil {
+			fmt.Printf("❌ 발행 옵션 오류: %v\n", err)
+			exit(1)
+		}
 
 		fmt.Printf("📝 카테고리: %s | 대상 계정: %d개\n", category, len(accounts))
 		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 
 		for _, acc := range accounts {
-			fmt.Printf("\n🔄 [%s] 포스팅 시작...\n", acc.Name)
+			ctx := logging.With(ctx, logging.KeyAccount, acc.Name, logging.KeyCategory, category)
+			ctx, run := beginRun(ctx, runs.TriggerPost, acc.Name, category)
+			logging.From(ctx).Info("🔄 포스팅 시작")
 
-			// 쿠팡 카테고리인데 쿠팡 설정이 없으면 건너뛰기
-			if category == "coupang" && !acc.HasCoupang() {
-				fmt.Printf("  ⏭️ [%s] 쿠팡 파트너스 설정 없음, 건너뜀\n", acc.Name)
-				continue
-			}
-
-			// 카테고리 매핑 확인
-			post := generatePost(ctx, cfg, &acc, category)
-			if post == nil {
-				continue
-			}
-
-			categoryName := acc.GetCategoryName(post.Category)
-			if categoryName == "" {
-				fmt.Printf("  ℹ️ [%s] 카테고리 '%s' 미설정, 기본 카테고리 사용\n", acc.Name, post.Category)
-				// 빈 문자열 = 카테고리 선택 안 함 (기본 카테고리에 게시)
-			}
-
-			// 썸네일 생성
-			thumbnailPath := ""
-			if cfg.Thumbnail != nil && cfg.Thumbnail.Enabled {
-				thumbGen := thumbnail.NewGenerator(cfg.Thumbnail.OutputDir)
-				if path, err := thumbGen.GenerateForPost(category, post.Title); err == nil {
-					thumbnailPath = path
-					fmt.Printf("  🖼️ [%s] 썸네일 생성: %s\n", acc.Name, path)
-				} else {
-					fmt.Printf("  ⚠️ [%s] 썸네일 생성 실패: %v\n", acc.Name, err)
-				}
-				thumbGen.Cleanup()
-			}
-
-			// 티스토리 클라이언트 생성
-			client := tistory.NewClient(
-				acc.Tistory.Email,
-				acc.Tistory.Password,
-				acc.Tistory.BlogName,
-				cfg.Browser.Headless,
-				cfg.Browser.SlowMotion,
-			)
+			// 브라우저는 발행할 때 처음 뜸 (미리보기/건너뜀이면 띄우지 않음)
+			client := newAccountClient(cfg, &acc)
 			defer client.Close()
 
-			fmt.Printf("  📝 제목: %s\n", post.Title)
-
-			var result *tistory.PostResult
-			if thumbnailPath != "" {
-				result, err = client.WritePostWithThumbnail(ctx, post.Title, post.Content, categoryName, post.Tags, 3, thumbnailPath)
-			} else {
-				result, err = client.WritePost(ctx, post.Title, post.Content, categoryName, post.Tags, 3)
-			}
+			err := publisher(ctx, cfg, &acc, client, category, opts, run)
+			run.finish(ctx, cfg, err)
 			if err != nil {
-				fmt.Printf("  ❌ [%s] 포스팅 실패: %v\n", acc.Name, err)
-				continue
+				notifyFailed(ctx, acc.Name, category, run.rec.Title, err)
 			}
-
-			fmt.Printf("  ✅ [%s] 포스팅 완료! URL: %s\n", acc.Name, result.URL)
 		}
 
 		fmt.Println("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		if dryRun {
+			fmt.Printf("✅ 미리보기 생성 완료! (확인: tistory-bot preview serve --out %s)\n", previewDir)
+			return
+		}
 		fmt.Println("✅ 모든 계정 포스팅 완료!")
 	},
 }
@@ -195,10 +220,10 @@ var accountsCmd = &cobra.Command{
 	Use:   "accounts",
 	Short: "등록된 계정 목록 조회",
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
+		cfg, err := loadConfig()
 		if err != nil {
 			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		fmt.Println("📋 등록된 계정 목록")
@@ -212,19 +237,29 @@ var accountsCmd = &cobra.Command{
 
 			fmt.Printf("\n%d. %s %s\n", i+1, acc.Name, status)
 			fmt.Printf("   📧 티스토리: %s (%s.tistory.com)\n", acc.Tistory.Email, acc.Tistory.BlogName)
+			fmt.Printf("   🔑 비밀번호: %s\n", secretSource(cfg, i, acc.Tistory.Password, "tistory", "password"))
 
 			if acc.HasCoupang() {
 				fmt.Printf("   🛒 쿠팡: %s\n", acc.Coupang.PartnerID)
+				if acc.Coupang.SecretKey != "" {
+					fmt.Printf("      🔑 secret_key: %s\n", secretSource(cfg, i, acc.Coupang.SecretKey, "coupang", "secret_key"))
+				}
 			} else {
 				fmt.Printf("   🛒 쿠팡: ❌ 미설정\n")
 			}
 
 			if acc.HasNaver() {
-				fmt.Printf("   🌐 네이버: ✅ 설정됨\n")
+				fmt.Printf("   🌐 네이버: ✅ 설정됨 (client_secret: %s)\n", secretSource(cfg, i, acc.Naver.ClientSecret, "naver", "client_secret"))
 			}
 
 			fmt.Printf("   📂 카테고리: %d개\n", len(acc.Categories))
 
+			if t, err := theme.Load(cfg.ThemesDir, acc.Theme); err != nil {
+				fmt.Printf("   🎨 테마: ❌ %v\n", err)
+			} else {
+				fmt.Printf("   🎨 테마: %s\n", t.Describe())
+			}
+
 			if acc.Schedule.Enabled {
 				fmt.Printf("   ⏰ 스케줄: %d개 작업\n", len(acc.Schedule.Jobs))
 			}
@@ -237,34 +272,28 @@ var categoriesCmd = &cobra.Command{
 	Use:   "categories",
 	Short: "블로그 카테고리 목록 조회",
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
+		cfg, err := loadConfig()
 		if err != nil {
 			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		accounts := getTargetAccounts(cfg)
 		if len(accounts) == 0 {
 			fmt.Println("❌ 활성화된 계정이 없습니다.")
-			os.Exit(1)
+			exit(1)
 		}
 
 		for _, acc := range accounts {
-			fmt.Printf("\n📂 [%s] 카테고리 조회 중...\n", acc.Name)
+			ctx := logging.With(cmd.Context(), logging.KeyAccount, acc.Name)
+			logging.From(ctx).Info("📂 카테고리 조회 중...")
 
-			client := tistory.NewClient(
-				acc.Tistory.Email,
-				acc.Tistory.Password,
-				acc.Tistory.BlogName,
-				cfg.Browser.Headless,
-				cfg.Browser.SlowMotion,
-			)
+			client := newAccountClient(cfg, &acc)
 			defer client.Close()
 
-			ctx := context.Background()
 			categories, err := client.GetCategories(ctx)
 			if err != nil {
-				fmt.Printf("❌ [%s] 카테고리 조회 실패: %v\n", acc.Name, err)
+				logging.From(ctx).Error("❌ 카테고리 조회 실패", "err", err)
 				continue
 			}
 
@@ -281,63 +310,48 @@ var runCmd = &cobra.Command{
 	Use:   "run",
 	Short: "모든 카테고리 자동 포스팅 (모든 계정)",
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
+		cfg, err := loadConfig()
 		if err != nil {
 			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		accounts := getTargetAccounts(cfg)
 		if len(accounts) == 0 {
 			fmt.Println("❌ 활성화된 계정이 없습니다.")
-			os.Exit(1)
+			exit(1)
 		}
 
 		fmt.Println("🚀 티스토리 자동 포스팅 시작!")
 		fmt.Printf("📋 대상 계정: %d개\n", len(accounts))
 		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 
-		ctx := context.Background()
-		categories := []string{"crypto", "tech", "movie", "trend", "lotto", "lotto-predict", "weather", "fortune", "sports", "coupang"}
+		ctx := cmd.Context()
+
+		// 레지스트리에서 일괄 실행 대상 카테고리 수집
+		var categories []string
+		for _, src := range collector.Sources() {
+			if !src.Meta().SkipInRun {
+				categories = append(categories, src.Name())
+			}
+		}
 
 		for _, acc := range accounts {
-			fmt.Printf("\n\n📌 [%s] 포스팅 시작\n", acc.Name)
-			fmt.Println("────────────────────────────")
+			accCtx := logging.With(ctx, logging.KeyAccount, acc.Name)
+			logging.From(accCtx).Info("📌 포스팅 시작", "categories", len(categories))
 
-			client := tistory.NewClient(
-				acc.Tistory.Email,
-				acc.Tistory.Password,
-				acc.Tistory.BlogName,
-				cfg.Browser.Headless,
-				cfg.Browser.SlowMotion,
-			)
+			client := newAccountClient(cfg, &acc)
 
 			for _, cat := range categories {
-				fmt.Printf("\n  📝 [%s] 카테고리...\n", cat)
+				ctx := logging.With(accCtx, logging.KeyCategory, cat)
+				ctx, run := beginRun(ctx, runs.TriggerRun, acc.Name, cat)
+				logging.From(ctx).Info("📝 카테고리 시작")
 
-				// 쿠팡인데 설정 없으면 건너뛰기
-				if cat == "coupang" && !acc.HasCoupang() {
-					fmt.Printf("    ⏭️ 쿠팡 설정 없음, 건너뜀\n")
-					continue
-				}
-
-				post := generatePost(ctx, cfg, &acc, cat)
-				if post == nil {
-					continue
-				}
-
-				categoryName := acc.GetCategoryName(post.Category)
-				if categoryName == "" {
-					fmt.Printf("    ℹ️ 카테고리 '%s' 미설정, 기본 카테고리 사용\n", post.Category)
-				}
-
-				_, err := client.WritePost(ctx, post.Title, post.Content, categoryName, post.Tags, 3)
+				err := publisher(ctx, cfg, &acc, client, cat, tistory.PublishOptions{}, run)
+				run.finish(ctx, cfg, err)
 				if err != nil {
-					fmt.Printf("    ❌ 포스팅 실패: %v\n", err)
-					continue
+					notifyFailed(ctx, acc.Name, cat, run.rec.Title, err)
 				}
-
-				fmt.Printf("    ✅ 완료: %s\n", post.Title)
 			}
 
 			client.Close()
@@ -348,100 +362,6 @@ var runCmd = &cobra.Command{
 	},
 }
 
-// schedule 명령어 - 자동 스케줄 실행
-var scheduleCmd = &cobra.Command{
-	Use:   "schedule",
-	Short: "자동 스케줄러 실행 (모든 계정)",
-	Long: `설정된 스케줄에 따라 자동으로 포스팅합니다.
-모든 활성화된 계정에 대해 각각의 스케줄을 실행합니다.
-프로그램을 종료하려면 Ctrl+C를 누르세요.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
-		if err != nil {
-			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
-		}
-
-		accounts := cfg.GetEnabledAccounts()
-		if len(accounts) == 0 {
-			fmt.Println("❌ 활성화된 계정이 없습니다.")
-			os.Exit(1)
-		}
-
-		fmt.Println("🚀 티스토리 자동 포스팅 스케줄러 시작!")
-		fmt.Printf("📋 활성 계정: %d개\n", len(accounts))
-		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-
-		// 각 계정별로 브라우저 열고 로그인 (한 번만)
-		fmt.Println("\n🔐 계정별 브라우저 초기화 중...")
-		ctx := context.Background()
-		for _, acc := range accounts {
-			client := tistory.NewClient(
-				acc.Tistory.Email,
-				acc.Tistory.Password,
-				acc.Tistory.BlogName,
-				cfg.Browser.Headless,
-				cfg.Browser.SlowMotion,
-			)
-			if err := client.Login(ctx); err != nil {
-				fmt.Printf("  ❌ [%s] 로그인 실패: %v\n", acc.Name, err)
-				continue
-			}
-			clientMap[acc.Name] = client
-			fmt.Printf("  ✅ [%s] 브라우저 준비 완료\n", acc.Name)
-		}
-		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-
-		c := cron.New()
-
-		for _, acc := range accounts {
-			if !acc.Schedule.Enabled || len(acc.Schedule.Jobs) == 0 {
-				fmt.Printf("\n⏭️ [%s] 스케줄 비활성화\n", acc.Name)
-				continue
-			}
-
-			fmt.Printf("\n📅 [%s] 스케줄 등록:\n", acc.Name)
-
-			for _, job := range acc.Schedule.Jobs {
-				category := job.Category
-				cronExpr := job.Cron
-				accCopy := acc // 클로저용 복사
-
-				fmt.Printf("  • %s: %s\n", category, cronExpr)
-
-				c.AddFunc(cronExpr, func() {
-					// 랜덤 딜레이 (0~45분) - 자동화 티 안 나게
-					rand.Seed(time.Now().UnixNano())
-					delay := time.Duration(rand.Intn(45)) * time.Minute
-					fmt.Printf("\n⏰ [%s] 스케줄 트리거: %s (%.0f분 후 실행)\n", accCopy.Name, category, delay.Minutes())
-					time.Sleep(delay)
-					fmt.Printf("▶️ [%s] 포스팅 시작: %s\n", accCopy.Name, category)
-					runPostForAccount(cfg, &accCopy, category)
-				})
-			}
-		}
-
-		fmt.Println("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-		fmt.Println("⏳ 스케줄 대기 중... (종료: Ctrl+C)")
-
-		c.Start()
-
-		sigChan := make(chan os.Signal, 1)
-		signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-		<-sigChan
-
-		fmt.Println("\n🛑 스케줄러 종료...")
-		c.Stop()
-
-		// 모든 브라우저 닫기
-		fmt.Println("🔒 브라우저 종료 중...")
-		for name, client := range clientMap {
-			client.Close()
-			fmt.Printf("  ✅ [%s] 브라우저 종료\n", name)
-		}
-	},
-}
-
 // getTargetAccounts 대상 계정 목록 반환
 func getTargetAccounts(cfg *config.Config) []config.AccountConfig {
 	accounts := cfg.GetEnabledAccounts()
@@ -460,217 +380,198 @@ func getTargetAccounts(cfg *config.Config) []config.AccountConfig {
 	return accounts
 }
 
-// generatePost 카테고리에 맞는 포스트 생성
-func generatePost(ctx context.Context, cfg *config.Config, acc *config.AccountConfig, category string) *collector.Post {
-	var post *collector.Post
-
-	switch category {
-	case "crypto":
-		c := collector.NewStockCollector()
-		cryptos, err := c.GetTopCryptos(ctx, 10)
-		if err != nil {
-			fmt.Printf("    ❌ 수집 실패: %v\n", err)
-			return nil
-		}
-		post = c.GenerateCryptoPost(cryptos)
-
-	case "tech":
-		c := collector.NewTechCollector()
-		news, err := c.GetTechNews(ctx, 10)
-		if err != nil {
-			fmt.Printf("    ❌ 수집 실패: %v\n", err)
-			return nil
-		}
-		post = c.GenerateTechPost(news)
-
-	case "game":
-		c := collector.NewGameCollector(acc.Coupang.PartnerID)
-		news, err := c.GetGameNews(ctx)
-		if err != nil {
-			fmt.Printf("    ❌ 수집 실패: %v\n", err)
-			return nil
-		}
-		post = c.GenerateGamePost(news)
-
-	case "movie":
-		c := collector.NewMovieCollector(cfg.TMDB.APIKey, acc.Coupang.PartnerID)
-		movies, err := c.GetNowPlaying(ctx, 10)
-		if err != nil {
-			fmt.Printf("    ❌ 수집 실패: %v\n", err)
-			return nil
-		}
-		post = c.GenerateMoviePost(movies, "now_playing")
-
-	case "trend":
-		c := collector.NewTrendCollector()
-		// 실제 API 연동: 구글 트렌드 RSS + 네이버 뉴스 RSS
-		trends, err := c.GetAllTrends(ctx)
-		if err != nil {
-			fmt.Printf("    ⚠️ 실시간 트렌드 수집 실패, 백업 데이터 사용: %v\n", err)
-		}
-		if len(trends) > 15 {
-			trends = trends[:15] // 최대 15개
-		}
-		post = c.GenerateTrendPost(trends)
-
-	case "lotto":
-		c := collector.NewLottoCollector()
-		result, err := c.GetLatestLotto(ctx)
-		if err != nil {
-			fmt.Printf("    ❌ 수집 실패: %v\n", err)
-			return nil
-		}
-		post = c.GenerateLottoPost(result)
+// loadConfig 설정을 읽어 바로 적용 (로거, 알림 대상, 클라이언트 공용 값)
+func loadConfig() (*config.Config, error) {
+	cfg, deps, err := readConfig()
+	if err != nil {
+		return nil, err
+	}
+	if err := setupLogging(cfg); err != nil {
+		return nil, err
+	}
+	if err := notifier.Configure(cfg.Notify); err != nil {
+		return nil, err
+	}
+	setDeps(deps) // 설정을 다시 읽을 때 프로필을 지운 경우 포함
+	return cfg, nil
+}
 
-	case "lotto-predict":
-		c := collector.NewLottoCollector()
-		results, err := c.GetRecentResults(ctx, 20)
-		if err != nil {
-			fmt.Printf("    ❌ 분석 실패: %v\n", err)
-			return nil
-		}
-		hotNumbers, coldNumbers := c.AnalyzeNumbers(results)
-		predictions := c.GeneratePredictions(hotNumbers, coldNumbers, acc.Name)
-		nextRound := results[0].DrawNo + 1
-		post = c.GeneratePredictionPost(nextRound, predictions, hotNumbers, coldNumbers)
-
-	case "weather":
-		c := collector.NewWeatherCollector()
-		weathers, err := c.GetWeather(ctx)
-		if err != nil {
-			fmt.Printf("    ❌ 수집 실패: %v\n", err)
-			return nil
-		}
-		post = c.GenerateWeatherPost(weathers)
-
-	case "fortune":
-		c := collector.NewFortuneCollector(acc.Coupang.PartnerID)
-		fortunes := c.GetTodayFortune()
-		post = c.GenerateFortunePost(fortunes)
-
-	case "sports":
-		// 실시간 스포츠 API 연동 (Football-Data.org, NBA API)
-		footballAPIKey := ""
-		if cfg.FootballData != nil {
-			footballAPIKey = cfg.FootballData.APIKey
-		}
-		c := collector.NewSportsCollectorWithAPI(acc.Coupang.PartnerID, footballAPIKey)
-		news, err := c.GetSportsNews(ctx)
-		if err != nil {
-			fmt.Printf("    ⚠️ 실시간 스포츠 뉴스 수집 실패: %v\n", err)
-			// 경기 데이터는 GenerateSportsPost 내부에서 자동 처리
+// readConfig 설정 로드 + 수집기 레지스트리 기준 카테고리 검증 + 클라이언트 공용 값 준비
+// 전역 상태는 바꾸지 않는다 (스케줄러가 실행 중일 때 다시 읽고 검증이 끝난 뒤 교체하도록).
+func readConfig() (*config.Config, clientDeps, error) {
+	cfg, err := config.LoadWithRegistry(cfgFile, collectorRegistry)
+	if err != nil {
+		return nil, clientDeps{}, err
+	}
+	var deps clientDeps
+	if cfg.Browser.SelectorProfile != "" {
+		if deps.profile, err = tistory.LoadProfile(cfg.Browser.SelectorProfile); err != nil {
+			return nil, clientDeps{}, err
 		}
-		post = c.GenerateSportsPost(news)
+	}
+	if deps.images, err = imageCache(cfg); err != nil {
+		return nil, clientDeps{}, err
+	}
+	return cfg, deps, nil
+}
 
-	case "coupang":
-		if !acc.HasCoupang() {
-			fmt.Printf("    ⏭️ 쿠팡 설정 없음, 건너뜀\n")
-			return nil
-		}
-		c := collector.NewCoupangCollector(acc.Coupang.PartnerID)
-		products, err := c.GetGoldboxProducts(ctx, 10)
-		if err != nil {
-			fmt.Printf("    ❌ 크롤링 실패: %v\n", err)
-			return nil
-		}
-		if len(products) == 0 {
-			fmt.Printf("    ❌ 상품 없음, 건너뜀\n")
-			return nil
-		}
-		post = c.GenerateCoupangPost(products)
+// newAccountClient 계정 설정으로 브라우저 클라이언트 생성 (셀렉터 프로필 적용)
+func newAccountClient(cfg *config.Config, acc *config.AccountConfig) *tistory.Client {
+	client := tistory.NewClient(
+		acc.Tistory.Email,
+		acc.Tistory.Password,
+		acc.Tistory.BlogName,
+		cfg.Browser.Headless,
+		cfg.Browser.SlowMotion,
+	)
+	deps := currentDeps()
+	client.SetProfile(deps.profile)
+	client.SetImages(deps.images)
+	client.SetScreenshotDir(cfg.Browser.ScreenshotDir)
+	return client
+}
 
-	case "golf":
-		coupangID := ""
-		if acc.HasCoupang() {
-			coupangID = acc.Coupang.PartnerID
-		}
-		c := collector.NewGolfCollector(coupangID)
-		post = c.GenerateGolfPost(ctx)
+// imageCache 본문 이미지 업로드가 켜져 있으면 이미지 캐시 (꺼짐이면 nil)
+func imageCache(cfg *config.Config) (*media.Cache, error) {
+	if cfg.Images == nil || !cfg.Images.Enabled {
+		return nil, nil
+	}
+	return media.NewCache(cfg.Images.CacheDir, media.Options{
+		MaxWidth: cfg.Images.MaxWidth,
+		MaxBytes: cfg.Images.MaxSizeKB << 10,
+		LocalDir: cfg.Images.LocalDir,
+	})
+}
 
-	case "golf-tips":
-		coupangID := ""
-		if acc.HasCoupang() {
-			coupangID = acc.Coupang.PartnerID
-		}
-		c := collector.NewGolfTipsCollector(coupangID)
-		post = c.GenerateGolfTipsPost(ctx)
+// collectPost 수집기 레지스트리로 포스트 생성 + 중복 검사 (건너뜀이면 nil, nil)
+func collectPost(ctx context.Context, cfg *config.Config, acc *config.AccountConfig, category string) (*collector.Post, error) {
+	src, ok := collector.Lookup(category)
+	if !ok {
+		return nil, queue.Permanent(fmt.Errorf("알 수 없는 카테고리: %s", category))
+	}
 
-	case "error":
-		c := collector.NewErrorArchiveCollector()
-		post = c.GenerateErrorPost(ctx)
+	env := &collector.Env{Config: cfg, Account: acc}
+	if missing := env.Missing(src.Meta().Requires); len(missing) > 0 {
+		logging.From(ctx).Info("⏭️ 필수 설정 없음, 건너뜀", "missing", missing)
+		return nil, nil
+	}
 
-	default:
-		fmt.Printf("    ❌ 알 수 없는 카테고리: %s\n", category)
-		return nil
+	post, err := src.Collect(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	if post == nil {
+		return nil, nil
+	}
+	if post.CreatedAt.IsZero() {
+		post.CreatedAt = time.Now()
 	}
 
-	return post
+	// 최근 발행 이력과 중복 검사
+	return guardDuplicate(ctx, env, category, post), nil
 }
 
-// runPostForAccount 특정 계정에 포스팅
-func runPostForAccount(cfg *config.Config, acc *config.AccountConfig, category string) {
-	ctx := context.Background()
-
-	// 쿠팡인데 설정 없으면 건너뛰기
-	if category == "coupang" && !acc.HasCoupang() {
-		fmt.Printf("  ⏭️ [%s] 쿠팡 설정 없음, 건너뜀\n", acc.Name)
-		return
+// runPostForAccount 스케줄 작업 1건 포스팅 (스케줄러가 로그인해 둔 클라이언트 사용, 없으면 새로 생성)
+func runPostForAccount(ctx context.Context, cfg *config.Config, acc *config.AccountConfig, category string, opts tistory.PublishOptions, run *runRecorder) error {
+	client, exists := clients.Get(acc.Name)
+	if !exists {
+		client = newAccountClient(cfg, acc)
+		defer client.Close()
+	} else {
+		client.SetImages(currentDeps().images) // 설정을 다시 읽었을 수 있으므로 매번 적용
+		client.SetScreenshotDir(cfg.Browser.ScreenshotDir)
 	}
+	// 스케줄러에서 사용 시에는 Close하지 않음 (브라우저 유지)
+	return publisher(ctx, cfg, acc, client, category, opts, run)
+}
 
-	post := generatePost(ctx, cfg, acc, category)
+// publisher 카테고리 1건 포스팅 (post/run/스케줄 공통: 수집 → 미리보기 또는 썸네일 → 발행 → 이력/알림/지표)
+// 건너뜀은 nil, 재시도 불가 에러는 queue.Permanent. 제목/썸네일/발행 결과는 run에 채우고,
+// 저장(finish)과 실패 알림은 재시도 여부를 아는 호출한 쪽이 한다.
+func publisher(ctx context.Context, cfg *config.Config, acc *config.AccountConfig, client *tistory.Client, category string, opts tistory.PublishOptions, run *runRecorder) error {
+	log := logging.From(ctx)
+	post, err := collectPost(ctx, cfg, acc, category)
+	if err != nil {
+		log.Error("❌ 수집 실패", "err", err)
+		return err
+	}
 	if post == nil {
-		return
+		return nil
+	}
+	run.rec.Title = post.Title
+
+	// 드라이런: 발행하지 않고 미리보기만 저장 (post --dry-run)
+	if dryRun {
+		writePreview(ctx, acc, category, post)
+		run.rec.Status = runs.StatusPreview
+		return nil
 	}
 
 	categoryName := acc.GetCategoryName(post.Category)
 	if categoryName == "" {
-		fmt.Printf("  ℹ️ [%s] 카테고리 '%s' 미설정, 기본 카테고리 사용\n", acc.Name, post.Category)
+		// 빈 문자열 = 카테고리 선택 안 함 (기본 카테고리에 게시)
+		log.Info("ℹ️ 카테고리 미설정, 기본 카테고리 사용", "post_category", post.Category)
 	}
 
-	// 썸네일 생성
-	thumbnailPath := ""
-	if cfg.Thumbnail != nil && cfg.Thumbnail.Enabled {
-		thumbGen := thumbnail.NewGenerator(cfg.Thumbnail.OutputDir)
-		if path, err := thumbGen.GenerateForPost(category, post.Title); err == nil {
-			thumbnailPath = path
-			fmt.Printf("  🖼️ [%s] 썸네일 생성: %s\n", acc.Name, path)
-		} else {
-			fmt.Printf("  ⚠️ [%s] 썸네일 생성 실패: %v\n", acc.Name, err)
-		}
-		// 오래된 썸네일 정리
-		thumbGen.Cleanup()
+	thumbnailPath := generateThumbnail(ctx, cfg, category, post)
+	run.rec.Thumbnail = thumbnailPath
+	opts.CoverInBody = cfg.Thumbnail != nil && cfg.Thumbnail.InBody
+
+	log.Info("📝 발행 준비", "title", post.Title, "publish", opts.Describe())
+	result, err := client.WritePost(ctx, tistory.Post{
+		Title:     post.Title,
+		Content:   post.Content,
+		Category:  categoryName,
+		Tags:      post.Tags,
+		Thumbnail: thumbnailPath,
+		Options:   opts,
+		BeforePublish: func(baseline int) error {
+			return run.publishing(post.Title, baseline)
+		},
+	})
+	if err != nil {
+		logPublishError(ctx, err)
+		return classifyPublishError(err)
 	}
 
-	// 전역 클라이언트 사용 (스케줄러에서 미리 로그인된 상태)
-	client, exists := clientMap[acc.Name]
-	if !exists {
-		// 클라이언트가 없으면 새로 생성 (post 명령어 직접 실행 시)
-		client = tistory.NewClient(
-			acc.Tistory.Email,
-			acc.Tistory.Password,
-			acc.Tistory.BlogName,
-			cfg.Browser.Headless,
-			cfg.Browser.SlowMotion,
-		)
-		defer client.Close()
+	run.published(result)
+	recordHistory(ctx, cfg, acc, category, post, result)
+	notifyPublished(ctx, acc, category, post.Title, result)
+	if result.Draft {
+		observePost(acc.Name, category, metrics.ResultDraft)
+		log.Info("💾 임시저장 완료 (관리 페이지에서 검토 후 발행하세요)", "title", post.Title)
+		return nil
 	}
-	// 스케줄러에서 사용 시에는 Close하지 않음 (브라우저 유지)
+	observePost(acc.Name, category, metrics.ResultPublished)
+	log.Info("✅ 포스팅 완료", "publish", opts.Describe(), "title", post.Title, "url", result.URL, "cover", result.Cover.Source)
+	return nil
+}
 
-	var err error
-	if thumbnailPath != "" {
-		// 썸네일 포함 포스팅
-		_, err = client.WritePostWithThumbnail(ctx, post.Title, post.Content, categoryName, post.Tags, 3, thumbnailPath)
-	} else {
-		// 일반 포스팅
-		_, err = client.WritePost(ctx, post.Title, post.Content, categoryName, post.Tags, 3)
+// generateThumbnail 썸네일 설정이 켜져 있으면 생성 (실패/꺼짐이면 빈 경로)
+func generateThumbnail(ctx context.Context, cfg *config.Config, category string, post *collector.Post) string {
+	if cfg.Thumbnail == nil || !cfg.Thumbnail.Enabled {
+		return ""
 	}
+	log := logging.From(ctx)
+	thumbGen := thumbnail.NewGenerator(cfg.Thumbnail.OutputDir)
+	defer thumbGen.Cleanup() // 오래된 썸네일 정리
 
+	path, err := thumbGen.GenerateForPost(category, post.Title)
 	if err != nil {
-		fmt.Printf("  ❌ [%s] 포스팅 실패: %v\n", acc.Name, err)
-		return
+		log.Warn("⚠️ 썸네일 생성 실패", "err", err)
+		return ""
 	}
+	log.Info("🖼️ 썸네일 생성", "path", path)
+	return path
+}
 
-	fmt.Printf("  ✅ [%s] 포스팅 완료: %s\n", acc.Name, post.Title)
+// logPublishError 발행 실패 로그 (해결 힌트가 있으면 함께)
+func logPublishError(ctx context.Context, err error) {
+	if hint := publishErrorHint(err); hint != "" {
+		logging.From(ctx).Error("❌ 포스팅 실패", "err", err, "hint", hint)
+		return
+	}
+	logging.From(ctx).Error("❌ 포스팅 실패", "err", err)
 }
 
 // analytics 명령어 - 콘텐츠 성과 분석
@@ -690,22 +591,23 @@ var analyticsCollectCmd = &cobra.Command{
 	Use:   "collect",
 	Short: "통계 데이터 수집",
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
+		cfg, err := loadConfig()
 		if err != nil {
 			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		accounts := getTargetAccounts(cfg)
 		if len(accounts) == 0 {
 			fmt.Println("❌ 활성화된 계정이 없습니다.")
-			os.Exit(1)
+			exit(1)
 		}
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		for _, acc := range accounts {
-			fmt.Printf("\n📊 [%s] 통계 수집 시작...\n", acc.Name)
+			ctx := logging.With(ctx, logging.KeyAccount, acc.Name)
+			logging.From(ctx).Info("📊 통계 수집 시작")
 
 			dataDir := "./analytics_data"
 			analyzer := analytics.NewAnalyzer(
@@ -719,11 +621,11 @@ var analyticsCollectCmd = &cobra.Command{
 
 			stats, err := analyzer.CollectStats(ctx)
 			if err != nil {
-				fmt.Printf("  ❌ 수집 실패: %v\n", err)
+				logging.From(ctx).Error("❌ 통계 수집 실패", "err", err)
 				continue
 			}
 
-			fmt.Printf("  ✅ %d개 포스트 통계 수집 완료!\n", len(stats))
+			logging.From(ctx).Info("✅ 포스트 통계 수집 완료!", "posts", len(stats))
 		}
 	},
 }
@@ -733,16 +635,16 @@ var analyticsReportCmd = &cobra.Command{
 	Use:   "report",
 	Short: "분석 리포트 생성",
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
+		cfg, err := loadConfig()
 		if err != nil {
 			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		accounts := getTargetAccounts(cfg)
 		if len(accounts) == 0 {
 			fmt.Println("❌ 활성화된 계정이 없습니다.")
-			os.Exit(1)
+			exit(1)
 		}
 
 		for _, acc := range accounts {
@@ -780,16 +682,16 @@ var analyticsOptimizeCmd = &cobra.Command{
 	Use:   "optimize",
 	Short: "스케줄 최적화 제안",
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg, err := config.Load(cfgFile)
+		cfg, err := loadConfig()
 		if err != nil {
 			fmt.Printf("설정 로드 실패: %v\n", err)
-			os.Exit(1)
+			exit(1)
 		}
 
 		accounts := getTargetAccounts(cfg)
 		if len(accounts) == 0 {
 			fmt.Println("❌ 활성화된 계정이 없습니다.")
-			os.Exit(1)
+			exit(1)
 		}
 
 		for _, acc := range accounts {
@@ -819,7 +721,8 @@ var analyticsOptimizeCmd = &cobra.Command{
 
 			fmt.Printf("\n🎯 [%s] 최적화된 스케줄 제안\n", acc.Name)
 			fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
-			fmt.Println("config.yaml의 schedule.jobs에 적용하세요:\n")
+			fmt.Println("config.yaml의 schedule.jobs에 적용하세요:")
+			fmt.Println()
 
 			for category, cron := range optimizedSchedule {
 				fmt.Printf("- category: %s\n", category)
@@ -831,9 +734,76 @@ var analyticsOptimizeCmd = &cobra.Command{
 	},
 }
 
+// categoryHelp 레지스트리 기반 카테고리 도움말
+func categoryHelp() string {
+	var b strings.Builder
+	for _, src := range collector.Sources() {
+		fmt.Fprintf(&b, "  %-14s - %s\n", src.Name(), src.Meta().Description)
+	}
+	return b.String()
+}
+
 func init() {
+	postCmd.Long = "지정한 카테고리의 글을 자동으로 작성합니다.\n\n" +
+		"--account [name] 옵션으로 특정 계정만 포스팅 가능\n\n" +
+		"카테고리:\n" + categoryHelp()
+	postCmd.ValidArgs = collector.Names()
+	postCmd.Flags().BoolVar(&dryRun, "dry-run", false, "발행하지 않고 미리보기만 저장")
+	postCmd.Flags().StringVar(&previewDir, "out", "./preview", "미리보기 저장 디렉토리")
+	postCmd.Flags().StringVar(&publishVisibility, "visibility", "public", "공개 범위 (public | protected | private)")
+	postCmd.Flags().StringVar(&publishPassword, "password", "", "보호글 비밀번호 (--visibility protected)")
+	postCmd.Flags().BoolVar(&publishDraft, "draft", false, "발행하지 않고 임시저장")
+	postCmd.Flags().StringVar(&publishReserve, "reserve", "", "예약 발행 시각 (예: \"2025-01-02 09:30\")")
+
+	scheduleCmd.Flags().BoolVar(&schedulePlan, "plan", false, "실행하지 않고 앞으로 7일의 발행 계획만 출력 (schedule plan)")
+	scheduleCmd.Flags().StringVar(&metricsAddr, "metrics-addr", "", "헬스 체크/지표 HTTP 주소 (예: 127.0.0.1:9090, /healthz /metrics, 빈 값 = 끔)")
+	scheduleCmd.Flags().DurationVar(&reloadInterval, "reload-interval", defaultReloadInterval, "설정 파일 변경 확인 간격 (0 = SIGHUP으로만 다시 읽기)")
+	schedulePlanCmd.Flags().IntVar(&planDays, "days", defaultPlanDays, "계획 기간 (일)")
+	schedulePlanCmd.Flags().StringVar(&planFormat, "format", "table", "출력 형식 (table | json | ics)")
+	schedulePlanCmd.Flags().StringVar(&planOut, "out", "", "출력 파일 (생략시 표준 출력)")
+	scheduleCmd.AddCommand(schedulePlanCmd)
+
+	// preview 하위 명령어 등록
+	previewServeCmd.Flags().StringVar(&previewDir, "out", "./preview", "미리보기 디렉토리")
+	previewServeCmd.Flags().StringVar(&previewAddr, "addr", "127.0.0.1:8089", "서버 주소")
+	previewCmd.AddCommand(previewServeCmd)
+
+	postsListCmd.Flags().StringVar(&postsCategory, "category", "", "카테고리 이름으로 필터")
+	postsListCmd.Flags().StringVar(&postsSearch, "search", "", "제목 검색어")
+	postsListCmd.Flags().IntVar(&postsPage, "page", 1, "관리 목록 페이지")
+	postsUpdateCmd.Flags().StringVar(&postsCategory, "category", "", "변경할 티스토리 카테고리 이름")
+	postsUpdateCmd.Flags().StringVar(&postsTitle, "title", "", "변경할 제목")
+	postsUpdateCmd.Flags().StringVar(&postsContentFile, "content-file", "", "교체할 본문 HTML 파일")
+	postsUpdateCmd.Flags().StringVar(&postsTags, "tags", "", "교체할 태그 (쉼표 구분)")
+	postsDeleteCmd.Flags().BoolVar(&postsYes, "yes", false, "확인 없이 삭제")
+	postsCmd.AddCommand(postsListCmd)
+	postsCmd.AddCommand(postsUpdateCmd)
+	postsCmd.AddCommand(postsDeleteCmd)
+
+	doctorEditorCmd.Flags().StringVar(&doctorProfile, "profile", "", "확인할 셀렉터 프로필 파일 (생략시 설정값)")
+	doctorCmd.AddCommand(doctorEditorCmd)
+
+	fixturesCmd.PersistentFlags().StringVar(&fixturesDir, "dir", "internal/collector/testdata", "녹화/골든 파일 디렉토리")
+	fixturesCheckCmd.Flags().BoolVar(&fixturesUpdate, "update", false, "비교 대신 골든 파일 갱신")
+	fixturesCmd.AddCommand(fixturesRecordCmd)
+	fixturesCmd.AddCommand(fixturesCheckCmd)
+
+	configCmd.AddCommand(configValidateCmd)
+
+	statusCmd.Flags().IntVar(&statusLimit, "limit", defaultStatusLimit, "계정별 최근 실행 표시 수")
+
+	// secrets 하위 명령어 등록
+	secretsCmd.PersistentFlags().StringVar(&vaultFile, "vault", "", "비밀 저장소 파일 (생략 시 설정의 secrets.vault)")
+	secretsCmd.AddCommand(secretsInitCmd)
+	secretsCmd.AddCommand(secretsSetCmd)
+	secretsCmd.AddCommand(secretsListCmd)
+	secretsCmd.AddCommand(secretsRmCmd)
+	secretsCmd.AddCommand(secretsPasswdCmd)
+
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "./config.yaml", "설정 파일 경로")
 	rootCmd.PersistentFlags().StringVar(&accountName, "account", "", "특정 계정만 실행 (생략시 전체)")
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "", "로그 레벨 debug | info | warn | error (생략시 log.level)")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "", "콘솔 로그 형식 text | json (생략시 log.format)")
 
 	// analytics 하위 명령어 등록
 	analyticsCmd.AddCommand(analyticsCollectCmd)
@@ -847,11 +817,49 @@ func init() {
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(scheduleCmd)
 	rootCmd.AddCommand(analyticsCmd)
+	rootCmd.AddCommand(previewCmd)
+	rootCmd.AddCommand(postsCmd)
+	rootCmd.AddCommand(doctorCmd)
+	rootCmd.AddCommand(fixturesCmd)
+	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(secretsCmd)
+	rootCmd.AddCommand(statusCmd)
+
+	// notify 하위 명령어 등록
+	notifyTestCmd.Flags().StringVar(&notifySink, "sink", "", "이 이름의 알림 대상에만 전송 (생략시 전체)")
+	notifyTestCmd.Flags().StringVar(&notifyEvent, "event", config.EventPublishFailure, "예시 이벤트 "+strings.Join(config.NotifyEvents, " | "))
+	notifyListenCmd.Flags().StringVar(&notifyAddr, "addr", "127.0.0.1:8099", "HTTP 대역 서버 주소")
+	notifyListenCmd.Flags().StringVar(&notifySMTPAddr, "smtp", "", "SMTP 대역 서버 주소 (예: 127.0.0.1:2525, 생략시 실행 안 함)")
+	notifyCmd.AddCommand(notifyTestCmd)
+	notifyCmd.AddCommand(notifyListenCmd)
+	rootCmd.AddCommand(notifyCmd)
 }
 
 func main() {
-	if err := rootCmd.Execute(); err != nil {
+	// 출력에 비밀 값(비밀번호, API 키)이 찍히지 않도록 표준 출력/에러를 가림 필터로 교체
+	restoreStdio = secrets.FilterStdio()
+	logging.Setup(nil, os.Stdout) // 설정을 읽기 전 기본 로거 (콘솔 text/info)
+
+	// Ctrl+C / SIGTERM 시 진행 중인 수집·브라우저 작업을 취소하는 루트 컨텍스트
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := rootCmd.ExecuteContext(ctx)
+	stop()
+	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		exit(1)
 	}
+	notifier.Wait()
+	closeLog()
+	restoreStdio()
+}
+
+// restoreStdio 가림 필터에 남은 출력을 내보내고 원래 표준 출력으로 되돌림
+var restoreStdio = func() {}
+
+// exit 남은 출력을 내보낸 뒤 종료 (os.Exit는 필터 고루틴을 기다리지 않음)
+func exit(code int) {
+	notifier.Wait() // 보내는 중인 알림 (notify.timeout까지)
+	closeLog()
+	restoreStdio()
+	os.Exit(code)
 }
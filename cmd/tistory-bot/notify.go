@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/notify"
+	"github.com/Song-wh/tistory-bot/internal/notify/fake"
+	"github.com/Song-wh/tistory-bot/internal/secrets"
+	"github.com/Song-wh/tistory-bot/internal/tistory"
+	"github.com/spf13/cobra"
+)
+
+// notifier 실행 알림 라우터 (loadConfig마다 설정의 notify 대상으로 교체, 일일 요약 집계는 유지)
+var notifier = notify.New()
+
+// notify 명령 플래그
+var (
+	notifySink     string
+	notifyEvent    string
+	notifyAddr     string
+	notifySMTPAddr string
+)
+
+// notifyPublished 발행(임시저장) 성공 알림
+func notifyPublished(ctx context.Context, acc *config.AccountConfig, category, title string, result *tistory.PostResult) {
+	notifier.Notify(ctx, notify.Event{
+		Kind:     notify.KindPublishSuccess,
+		Account:  acc.Name,
+		Category: category,
+		Title:    title,
+		URL:      result.URL,
+		Draft:    result.Draft,
+	})
+}
+
+// notifyFailed 발행 실패 알림 (로그인 실패/캡챠는 해당 이벤트로, 에러의 비밀 값은 가림)
+func notifyFailed(ctx context.Context, account, category, title string, err error) {
+	kind := notify.KindPublishFailure
+	switch {
+	case errors.Is(err, tistory.ErrCaptcha):
+		kind = notify.KindCaptcha
+	case errors.Is(err, tistory.ErrLoginFailed):
+		kind = notify.KindLoginFailure
+	}
+	notifier.Notify(ctx, notify.Event{
+		Kind:     kind,
+		Account:  account,
+		Category: category,
+		Title:    title,
+		Error:    secrets.Redact(err.Error()),
+		Hint:     publishErrorHint(err),
+	})
+}
+
+// notifyLoginFailed 로그인 실패 알림 (캡챠면 captcha 이벤트, 에러의 비밀 값은 가림)
+func notifyLoginFailed(ctx context.Context, account string, err error) {
+	kind := notify.KindLoginFailure
+	if errors.Is(err, tistory.ErrCaptcha) {
+		kind = notify.KindCaptcha
+	}
+	notifier.Notify(ctx, notify.Event{Kind: kind, Account: account, Error: secrets.Redact(err.Error())})
+}
+
+// isLoginError 다시 시도해도 사람이 로그인하기 전에는 풀리지 않을 수 있는 실패
+func isLoginError(err error) bool {
+	return errors.Is(err, tistory.ErrCaptcha) || errors.Is(err, tistory.ErrLoginFailed)
+}
+
+// notify 명령어 - 알림 설정 확인
+var notifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "실행 알림 확인 📨",
+	Long: `config.yaml의 notify.sinks 알림 대상을 확인합니다.
+
+하위 명령어:
+  test   - 예시 이벤트를 알림 대상에 바로 보내고 결과 출력
+  listen - 웹훅/Slack/Discord/Telegram/SMTP 대역 서버 실행 (받은 알림 출력)`,
+}
+
+// notify test 명령어
+var notifyTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "예시 알림 전송",
+	Long: `예시 이벤트를 notify.sinks 대상에 보내고 대상별 결과를 출력합니다.
+대상의 events / accounts 조건은 그대로 적용됩니다.
+
+  tistory-bot notify test                              # 발행 실패 예시를 모든 대상에
+  tistory-bot notify test --event daily_summary --sink ops-slack
+  tistory-bot notify test --event captcha --account my-blog`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := loadConfig()
+		if err != nil {
+			fmt.Printf("설정 로드 실패: %v\n", err)
+			exit(1)
+		}
+		if len(notifier.Sinks()) == 0 {
+			fmt.Println("❌ notify.sinks에 알림 대상이 없습니다.")
+			exit(1)
+		}
+
+		account := accountName
+		if account == "" && len(cfg.Accounts) > 0 {
+			account = cfg.Accounts[0].Name
+		}
+		event, err := sampleEvent(notify.Kind(notifyEvent), account)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+
+		fmt.Printf("📨 예시 알림 전송: %s (계정: %s)\n", event.Kind, account)
+		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		results := notifier.Send(cmd.Context(), event, notifySink)
+		if len(results) == 0 {
+			fmt.Println("⚠️ 이 이벤트/계정을 받는 대상이 없습니다. (events, accounts, --sink 확인)")
+			exit(1)
+		}
+		failed := false
+		for _, res := range results {
+			switch {
+			case res.Suppressed:
+				fmt.Printf("  🔕 %s: rate_limit으로 생략\n", res.Sink)
+			case res.Err != nil:
+				failed = true
+				fmt.Printf("  ❌ %s: %v\n", res.Sink, res.Err)
+			default:
+				fmt.Printf("  ✅ %s: 전송 완료\n", res.Sink)
+			}
+		}
+		if failed {
+			exit(1)
+		}
+	},
+}
+
+// sampleEvent notify test용 예시 이벤트
+func sampleEvent(kind notify.Kind, account string) (notify.Event, error) {
+	e := notify.Event{Kind: kind, Account: account, Category: "test", Time: time.Now()}
+	switch kind {
+	case notify.KindPublishSuccess:
+		e.Title = "알림 테스트 포스트"
+		e.URL = "https://example.tistory.com/1"
+	case notify.KindPublishFailure:
+		e.Title = "알림 테스트 포스트"
+		e.Error = tistory.ErrPublishNotConfirmed.Error() + " (notify test)"
+		e.Hint = publishErrorHint(tistory.ErrPublishNotConfirmed)
+	case notify.KindLoginFailure, notify.KindCaptcha:
+		e.Category = ""
+		e.Error = "notify test"
+	case notify.KindDailySummary:
+		e = notify.Event{Kind: kind, Time: e.Time, Summary: &notify.Summary{
+			Since:    e.Time.Add(-24 * time.Hour),
+			Until:    e.Time,
+			Accounts: []notify.AccountSummary{{Account: account, Published: 3, Failed: 1}},
+		}}
+	default:
+		return e, fmt.Errorf("알 수 없는 이벤트: %s (%s)", kind, strings.Join(config.NotifyEvents, " | "))
+	}
+	return e, nil
+}
+
+// notify listen 명령어
+var notifyListenCmd = &cobra.Command{
+	Use:   "listen",
+	Short: "알림 대역 서버 실행",
+	Long: `실제 서비스 대신 알림을 받아 출력하는 로컬 서버를 실행합니다.
+notify.sinks의 url(또는 telegram api_url, smtp host/port)을 이 주소로 바꾸고 notify test로 확인하세요.
+
+  url: "http://127.0.0.1:8099/slack"     # webhook / slack / discord
+  api_url: "http://127.0.0.1:8099"       # telegram
+  smtp: {host: 127.0.0.1, port: 2525}    # email (--smtp 127.0.0.1:2525)`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if notifySMTPAddr != "" {
+			smtpSrv, err := fake.NewSMTPServer(notifySMTPAddr, func(m fake.Mail) {
+				fmt.Printf("\n📧 %s  %s → %s\n%s\n", m.Received.Format("15:04:05"), m.From, strings.Join(m.To, ", "), m.Data)
+			})
+			if err != nil {
+				fmt.Printf("❌ SMTP 서버 실행 실패: %v\n", err)
+				exit(1)
+			}
+			defer smtpSrv.Close()
+			fmt.Printf("📧 SMTP 대역 서버: %s\n", smtpSrv.Addr)
+		}
+
+		rec := &fake.Recorder{OnRequest: func(r fake.Request) {
+			fmt.Printf("\n📨 %s  POST %s\n", r.Received.Format("15:04:05"), r.Path)
+			if r.Text != "" {
+				fmt.Println(r.Text)
+			} else {
+				fmt.Println(string(r.Body))
+			}
+		}}
+		srv := &http.Server{Addr: notifyAddr, Handler: rec}
+		go func() {
+			<-cmd.Context().Done()
+			srv.Close()
+		}()
+		fmt.Printf("📨 알림 대역 서버: http://%s/\n", notifyAddr)
+		fmt.Println("⏳ 종료: Ctrl+C")
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("❌ 서버 실행 실패: %v\n", err)
+			exit(1)
+		}
+	},
+}
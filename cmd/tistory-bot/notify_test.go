@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/notify"
+	"github.com/Song-wh/tistory-bot/internal/notify/fake"
+	"github.com/Song-wh/tistory-bot/internal/secrets"
+	"github.com/Song-wh/tistory-bot/internal/tistory"
+)
+
+// TestNotifyRedactsSecrets 실패 알림 에러에 섞인 비밀 값이 알림 대상에 전달되지 않음
+func TestNotifyRedactsSecrets(t *testing.T) {
+	const secret = "kakao-pw-Zx81!q"
+	secrets.Register(secret)
+
+	srv := fake.NewServer()
+	t.Cleanup(srv.Close)
+	saved := notifier
+	notifier = notify.New()
+	t.Cleanup(func() { notifier = saved })
+	if err := notifier.Configure(&config.NotifyConfig{Sinks: []config.NotifySink{
+		{Name: "hook", Type: "webhook", URL: srv.URL + "/hook"},
+		{Name: "chat", Type: "slack", URL: srv.URL + "/slack"},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		send func(ctx context.Context, err error)
+		err  error
+	}{
+		{"발행 실패", func(ctx context.Context, err error) { notifyFailed(ctx, "main", "fortune", "오늘의 운세", err) },
+			fmt.Errorf("publish 단계: 요청 본문 password=%s: %w", secret, tistory.ErrPublishNotConfirmed)},
+		{"발행 중 캡챠", func(ctx context.Context, err error) { notifyFailed(ctx, "main", "fortune", "오늘의 운세", err) },
+			fmt.Errorf("%w: %s 입력 후 캡챠", tistory.ErrCaptcha, secret)},
+		{"로그인 실패", func(ctx context.Context, err error) { notifyLoginFailed(ctx, "main", err) },
+			fmt.Errorf("%w: bot@example.com / %s", tistory.ErrLoginFailed, secret)},
+		{"비밀 값만 있는 에러", func(ctx context.Context, err error) { notifyLoginFailed(ctx, "main", err) },
+			errors.New(secret)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(srv.Requests())
+			tt.send(context.Background(), tt.err)
+			notifier.Wait()
+
+			reqs := srv.Requests()[before:]
+			if len(reqs) != 2 {
+				t.Fatalf("requests = %d, want 2", len(reqs))
+			}
+			for _, req := range reqs {
+				if strings.Contains(string(req.Body), secret) {
+					t.Errorf("%s: 비밀 값 전달됨: %s", req.Path, req.Body)
+				}
+				if !strings.Contains(req.Text, secrets.Mask) {
+					t.Errorf("%s: text = %q, want %s", req.Path, req.Text, secrets.Mask)
+				}
+			}
+		})
+	}
+}
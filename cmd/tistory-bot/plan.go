@@ -0,0 +1,355 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/humanize"
+	"github.com/spf13/cobra"
+)
+
+// defaultPlanDays schedule plan 기본 기간 (일)
+const defaultPlanDays = 7
+
+var planDays int      // --days
+var planFormat string // --format
+var planOut string    // --out
+
+// schedule plan 명령어
+var schedulePlanCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "앞으로의 발행 계획 확인 (표 / JSON / iCalendar)",
+	Long: `모든 계정의 스케줄 작업을 humanize 규칙까지 적용해 실제 발행 시각 타임라인으로 펼칩니다.
+
+- 여러 계정이 같은 분에 발행하면 충돌로 표시합니다
+- 하루 상한(humanize.daily_cap)을 넘어 건너뛰는 카테고리를 따로 모아 보여줍니다
+- --format ics로 팀 캘린더에 가져올 수 있는 iCalendar 파일을 만듭니다`,
+	Example: `  tistory-bot schedule plan
+  tistory-bot schedule plan --days 14 --account my-blog
+  tistory-bot schedule plan --format json
+  tistory-bot schedule plan --format ics --out plan.ics`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := loadConfig()
+		if err != nil {
+			fmt.Printf("설정 로드 실패: %v\n", err)
+			exit(1)
+		}
+		if err := runPlan(getTargetAccounts(cfg), time.Now(), planDays, planFormat, planOut); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+	},
+}
+
+// planEntry 타임라인의 발행 1회
+type planEntry struct {
+	Account   string    `json:"account"`
+	Category  string    `json:"category"`
+	TriggerAt time.Time `json:"trigger_at"`          // cron 트리거 시각
+	RunAt     time.Time `json:"run_at"`              // humanize 적용 후 실행 시각
+	Publish   string    `json:"publish,omitempty"`   // 발행 방식 (생략 = public)
+	Skipped   string    `json:"skipped,omitempty"`   // 건너뛰는 이유
+	Collision []string  `json:"collision,omitempty"` // 같은 분에 발행하는 다른 계정
+}
+
+// At 타임라인 위치 (건너뛰는 회차는 트리거 시각)
+func (e planEntry) At() time.Time {
+	if e.Skipped != "" {
+		return e.TriggerAt
+	}
+	return e.RunAt
+}
+
+// capOverflow 하루 상한을 넘어 건너뛰는 카테고리 (계정/날짜별)
+type capOverflow struct {
+	Account  string `json:"account"`
+	Category string `json:"category"`
+	Date     string `json:"date"`
+	Triggers int    `json:"triggers"` // 그날 트리거 수
+	Skipped  int    `json:"skipped"`  // 상한 때문에 건너뛰는 수
+	Reason   string `json:"reason"`
+}
+
+// publishPlan 계정 전체 발행 계획
+type publishPlan struct {
+	From    time.Time     `json:"from"`
+	To      time.Time     `json:"to"`
+	Entries []planEntry   `json:"entries"`
+	OverCap []capOverflow `json:"over_cap,omitempty"`
+}
+
+// runPlan 발행 계획을 계산해 format(table | json | ics)으로 출력 (out이 비면 표준 출력)
+func runPlan(accounts []config.AccountConfig, now time.Time, days int, format, out string) error {
+	if days < 1 {
+		return fmt.Errorf("--days는 1 이상이어야 함: %d", days)
+	}
+	var render func(io.Writer, *publishPlan) error
+	switch format {
+	case "table":
+		render = writePlanTable
+	case "json":
+		render = writePlanJSON
+	case "ics":
+		render = writePlanICS
+	default:
+		return fmt.Errorf("알 수 없는 형식: %s (table | json | ics)", format)
+	}
+
+	plan, err := buildPlan(accounts, now, now.AddDate(0, 0, days))
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		return render(os.Stdout, plan)
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return fmt.Errorf("출력 파일 생성 실패: %w", err)
+	}
+	if err := render(f, plan); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("출력 파일 저장 실패: %w", err)
+	}
+	fmt.Printf("✅ 발행 계획 저장 (%s): %s\n", format, out)
+	return nil
+}
+
+// buildPlan 계정별 계획을 하나의 타임라인으로 합치고 충돌/상한 초과 표시
+func buildPlan(accounts []config.AccountConfig, from, to time.Time) (*publishPlan, error) {
+	plan := &publishPlan{From: from, To: to}
+	for _, acc := range accounts {
+		slots, err := humanize.PlanAccount(&acc, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range slots {
+			e := planEntry{
+				Account:   s.Account,
+				Category:  s.Job.Category,
+				TriggerAt: s.TriggerAt,
+				RunAt:     s.RunAt,
+				Skipped:   s.Skipped,
+			}
+			if s.Job.Publish != nil {
+				e.Publish = s.Job.Publish.Mode
+			}
+			plan.Entries = append(plan.Entries, e)
+		}
+	}
+	sort.SliceStable(plan.Entries, func(i, j int) bool {
+		return plan.Entries[i].At().Before(plan.Entries[j].At())
+	})
+
+	// 같은 분에 발행하는 다른 계정 찾기
+	byMinute := map[time.Time][]int{}
+	for i, e := range plan.Entries {
+		if e.Skipped == "" {
+			minute := e.RunAt.Truncate(time.Minute)
+			byMinute[minute] = append(byMinute[minute], i)
+		}
+	}
+	for _, idx := range byMinute {
+		for _, i := range idx {
+			for _, j := range idx {
+				if other := plan.Entries[j].Account; other != plan.Entries[i].Account && !contains(plan.Entries[i].Collision, other) {
+					plan.Entries[i].Collision = append(plan.Entries[i].Collision, other)
+				}
+			}
+		}
+	}
+
+	// 하루 상한 때문에 건너뛰는 카테고리 (트리거 날짜 기준)
+	type capKey struct{ account, category, date string }
+	overflow := map[capKey]*capOverflow{}
+	triggers := map[capKey]int{}
+	var keys []capKey
+	for _, e := range plan.Entries {
+		k := capKey{e.Account, e.Category, e.TriggerAt.Format("2006-01-02")}
+		triggers[k]++
+		if e.Skipped == "" {
+			continue
+		}
+		if overflow[k] == nil {
+			overflow[k] = &capOverflow{Account: k.account, Category: k.category, Date: k.date, Reason: e.Skipped}
+			keys = append(keys, k)
+		}
+		overflow[k].Skipped++
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].date != keys[j].date {
+			return keys[i].date < keys[j].date
+		}
+		if keys[i].account != keys[j].account {
+			return keys[i].account < keys[j].account
+		}
+		return keys[i].category < keys[j].category
+	})
+	for _, k := range keys {
+		overflow[k].Triggers = triggers[k]
+		plan.OverCap = append(plan.OverCap, *overflow[k])
+	}
+	return plan, nil
+}
+
+// contains 문자열 슬라이스 포함 여부
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// writePlanTable 날짜별 타임라인 표
+func writePlanTable(w io.Writer, plan *publishPlan) error {
+	fmt.Fprintf(w, "🗓️ 발행 계획 (%s ~ %s)\n", plan.From.Format("01/02 15:04"), plan.To.Format("01/02 15:04"))
+
+	day := ""
+	planned, collisions := 0, 0
+	for _, e := range plan.Entries {
+		at := e.At()
+		if d := at.Format("01/02 (Mon)"); d != day {
+			day = d
+			fmt.Fprintln(w, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+			fmt.Fprintf(w, "📅 %s\n", day)
+		}
+
+		if e.Skipped != "" {
+			fmt.Fprintf(w, "  ⏭️ %s  %-12s %-14s 건너뜀 (%s)\n", at.Format("15:04"), e.Account, e.Category, e.Skipped)
+			continue
+		}
+		planned++
+		note := ""
+		if d := e.RunAt.Sub(e.TriggerAt); d >= time.Minute {
+			trigger := e.TriggerAt.Format("15:04")
+			if e.TriggerAt.YearDay() != e.RunAt.YearDay() {
+				trigger = e.TriggerAt.Format("01/02 15:04")
+			}
+			note = fmt.Sprintf("트리거 %s +%s", trigger, formatShift(d))
+		}
+		if e.Publish != "" && e.Publish != "public" {
+			note = strings.TrimSpace(note + " · " + e.Publish)
+		}
+		mark := "•"
+		if len(e.Collision) > 0 {
+			mark = "⚠️"
+			collisions++
+			note = strings.TrimSpace(note + " · 같은 분 발행: " + strings.Join(e.Collision, ", "))
+		}
+		fmt.Fprintf(w, "  %s %s  %-12s %-14s %s\n", mark, at.Format("15:04"), e.Account, e.Category, strings.TrimPrefix(note, "· "))
+	}
+
+	fmt.Fprintln(w, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	fmt.Fprintf(w, "📊 발행 %d회, 건너뜀 %d회, 계정 간 충돌 %d회\n", planned, len(plan.Entries)-planned, collisions)
+	if len(plan.OverCap) > 0 {
+		fmt.Fprintln(w, "\n🚫 하루 상한 초과:")
+		for _, o := range plan.OverCap {
+			fmt.Fprintf(w, "  • %s [%s] %s: 트리거 %d회 중 %d회 건너뜀 (%s)\n", o.Date, o.Account, o.Category, o.Triggers, o.Skipped, o.Reason)
+		}
+	}
+	return nil
+}
+
+// formatShift 트리거 대비 지연 시간 표시 (예: "1시간 5분")
+func formatShift(d time.Duration) string {
+	d = d.Round(time.Minute)
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%d분", m)
+	case m == 0:
+		return fmt.Sprintf("%d시간", h)
+	}
+	return fmt.Sprintf("%d시간 %d분", h, m)
+}
+
+// writePlanJSON 계획 전체를 JSON으로
+func writePlanJSON(w io.Writer, plan *publishPlan) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(plan); err != nil {
+		return fmt.Errorf("JSON 출력 실패: %w", err)
+	}
+	return nil
+}
+
+// icsEventLength 캘린더 일정 길이 (발행 작업 소요 시간 정도)
+const icsEventLength = 10 * time.Minute
+
+// writePlanICS 건너뛰지 않는 발행을 iCalendar(RFC 5545) 일정으로
+func writePlanICS(w io.Writer, plan *publishPlan) error {
+	const stamp = "20060102T150405Z"
+	now := time.Now().UTC().Format(stamp)
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//tistory-bot//schedule plan//KO",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:" + icsEscape("tistory-bot 발행 계획"),
+	}
+	for _, e := range plan.Entries {
+		if e.Skipped != "" {
+			continue
+		}
+		desc := fmt.Sprintf("트리거 %s", e.TriggerAt.Format("2006-01-02 15:04"))
+		if e.Publish != "" {
+			desc += "\n발행 방식: " + e.Publish
+		}
+		if len(e.Collision) > 0 {
+			desc += "\n같은 분 발행: " + strings.Join(e.Collision, ", ")
+		}
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			fmt.Sprintf("UID:%s-%s-%s@tistory-bot", e.Account, e.Category, e.TriggerAt.UTC().Format(stamp)),
+			"DTSTAMP:"+now,
+			"DTSTART:"+e.RunAt.UTC().Format(stamp),
+			"DTEND:"+e.RunAt.Add(icsEventLength).UTC().Format(stamp),
+			"SUMMARY:"+icsEscape(fmt.Sprintf("[%s] %s", e.Account, e.Category)),
+			"DESCRIPTION:"+icsEscape(desc),
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, icsFold(line)); err != nil {
+			return fmt.Errorf("iCalendar 출력 실패: %w", err)
+		}
+	}
+	return nil
+}
+
+// icsEscape iCalendar TEXT 값 이스케이프
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// icsFold 75바이트마다 줄을 접고 CRLF로 끝냄 (UTF-8 문자는 자르지 않음)
+func icsFold(line string) string {
+	const limit = 75
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		n := len(string(r))
+		if width+n > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	b.WriteString("\r\n")
+	return b.String()
+}
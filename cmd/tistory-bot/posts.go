@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/tistory"
+	"github.com/spf13/cobra"
+)
+
+// posts 명령 플래그
+var (
+	postsCategory    string
+	postsSearch      string
+	postsPage        int
+	postsTitle       string
+	postsContentFile string
+	postsTags        string
+	postsYes         bool
+)
+
+// posts 명령어 - 발행된 글 관리
+var postsCmd = &cobra.Command{
+	Use:   "posts",
+	Short: "발행된 글 조회/수정/삭제 🗂️",
+	Long: `티스토리 관리 페이지에서 발행된 글을 관리합니다.
+
+하위 명령어:
+  list          - 글 목록 조회
+  update [id]   - 글 수정 (카테고리/제목/본문/태그)
+  delete [id]   - 글 삭제
+
+계정이 여러 개면 --account [name] 으로 대상 계정을 지정하세요.`,
+}
+
+// posts list 명령어
+var postsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "글 목록 조회",
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, acc := mustSingleAccount()
+		client := newAccountClient(cfg, acc)
+		defer client.Close()
+
+		posts, err := client.ListPosts(cmd.Context(), tistory.PostFilter{
+			Category: postsCategory,
+			Search:   postsSearch,
+			Page:     postsPage,
+		})
+		if err != nil {
+			fmt.Printf("❌ [%s] 글 목록 조회 실패: %v\n", acc.Name, err)
+			exit(1)
+		}
+
+		fmt.Printf("\n🗂️ [%s] 글 목록 (%d건)\n", acc.Name, len(posts))
+		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+		for _, p := range posts {
+			fmt.Printf("  %-8s %-6s %-12s %s\n", p.PostID, p.Visibility, p.Category, p.Title)
+			if p.Date != "" {
+				fmt.Printf("  %-8s %s · %s\n", "", p.Date, p.URL)
+			}
+		}
+		fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	},
+}
+
+// posts update 명령어
+var postsUpdateCmd = &cobra.Command{
+	Use:   "update [id]",
+	Short: "글 수정 (카테고리/제목/본문/태그)",
+	Example: `  tistory-bot posts update 123 --category "골프"
+  tistory-bot posts update 123 --title "새 제목" --content-file ./post.html
+  tistory-bot posts update 123 --tags "골프,레슨,팁"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var upd tistory.PostUpdate
+		if cmd.Flags().Changed("category") {
+			upd.Category = &postsCategory
+		}
+		if cmd.Flags().Changed("title") {
+			upd.Title = &postsTitle
+		}
+		if postsContentFile != "" {
+			data, err := os.ReadFile(postsContentFile)
+			if err != nil {
+				fmt.Printf("❌ 본문 파일 읽기 실패: %v\n", err)
+				exit(1)
+			}
+			content := string(data)
+			upd.Content = &content
+		}
+		if cmd.Flags().Changed("tags") {
+			upd.Tags = splitTags(postsTags)
+		}
+		if upd.Category == nil && upd.Title == nil && upd.Content == nil && upd.Tags == nil {
+			fmt.Println("❌ 변경할 항목이 없습니다. (--category, --title, --content-file, --tags)")
+			exit(1)
+		}
+
+		cfg, acc := mustSingleAccount()
+		client := newAccountClient(cfg, acc)
+		defer client.Close()
+
+		fmt.Printf("\n✏️ [%s] 글 %s 수정 중...\n", acc.Name, args[0])
+		result, err := client.UpdatePost(logging.With(cmd.Context(), logging.KeyAccount, acc.Name), args[0], upd)
+		if err != nil {
+			fmt.Printf("❌ [%s] 글 수정 실패: %v\n", acc.Name, err)
+			exit(1)
+		}
+		fmt.Printf("✅ [%s] 글 수정 완료: %s\n", acc.Name, result.URL)
+	},
+}
+
+// posts delete 명령어
+var postsDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "글 삭제",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, acc := mustSingleAccount()
+
+		if !postsYes {
+			fmt.Printf("⚠️ [%s] 글 %s 을(를) 삭제합니다. 계속하려면 --yes 를 붙여 다시 실행하세요.\n", acc.Name, args[0])
+			exit(1)
+		}
+
+		client := newAccountClient(cfg, acc)
+		defer client.Close()
+
+		fmt.Printf("\n🗑️ [%s] 글 %s 삭제 중...\n", acc.Name, args[0])
+		if err := client.DeletePost(logging.With(cmd.Context(), logging.KeyAccount, acc.Name), args[0]); err != nil {
+			fmt.Printf("❌ [%s] 글 삭제 실패: %v\n", acc.Name, err)
+			exit(1)
+		}
+		fmt.Printf("✅ [%s] 글 %s 삭제 완료\n", acc.Name, args[0])
+	},
+}
+
+// mustSingleAccount 설정 로드 후 대상 계정 1개 선택 (여러 개면 --account 필요)
+func mustSingleAccount() (*config.Config, *config.AccountConfig) {
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Printf("설정 로드 실패: %v\n", err)
+		exit(1)
+	}
+
+	accounts := getTargetAccounts(cfg)
+	switch {
+	case len(accounts) == 0:
+		fmt.Println("❌ 활성화된 계정이 없습니다.")
+		exit(1)
+	case len(accounts) > 1:
+		fmt.Println("❌ 계정이 여러 개입니다. --account [name] 으로 대상 계정을 지정하세요.")
+		exit(1)
+	}
+	return cfg, &accounts[0]
+}
+
+// splitTags 쉼표 구분 태그 파싱
+func splitTags(s string) []string {
+	tags := []string{}
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
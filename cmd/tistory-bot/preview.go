@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/Song-wh/tistory-bot/internal/collector"
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/preview"
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
+	"github.com/spf13/cobra"
+)
+
+var dryRun bool       // post --dry-run: 발행하지 않고 미리보기만 저장
+var previewDir string // 미리보기 저장/서빙 디렉토리
+var previewAddr string
+
+// preview 명령어 - 미리보기 관리
+var previewCmd = &cobra.Command{
+	Use:   "preview",
+	Short: "발행 전 미리보기 관리 🔍",
+	Long: `post --dry-run 으로 생성한 미리보기를 관리합니다.
+
+하위 명령어:
+  serve - 미리보기 갤러리 로컬 서버 실행 (브라우저 자동화 없음)`,
+}
+
+// preview serve 명령어
+var previewServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "미리보기 갤러리 로컬 서버 실행",
+	Run: func(cmd *cobra.Command, args []string) {
+		if _, err := os.Stat(previewDir); err != nil {
+			fmt.Printf("⚠️ 미리보기 디렉토리 없음: %s (post --dry-run 으로 먼저 생성하세요)\n", previewDir)
+		}
+
+		fmt.Printf("🔍 미리보기 갤러리: http://%s/\n", previewAddr)
+		fmt.Printf("📁 디렉토리: %s\n", previewDir)
+		fmt.Println("⏳ 종료: Ctrl+C")
+
+		srv := &http.Server{Addr: previewAddr, Handler: preview.Handler(previewDir)}
+		go func() {
+			<-cmd.Context().Done()
+			srv.Close()
+		}()
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("❌ 서버 실행 실패: %v\n", err)
+			exit(1)
+		}
+	},
+}
+
+// writePreview 발행 대신 미리보기 저장 (본문, 태그, 카테고리 매핑, 썸네일)
+func writePreview(ctx context.Context, acc *config.AccountConfig, category string, post *collector.Post) {
+	log := logging.From(ctx)
+	// 썸네일은 임시 디렉토리에 생성 후 미리보기 디렉토리로 복사
+	thumbnailPath := ""
+	thumbGen := thumbnail.NewGenerator(os.TempDir())
+	if path, err := thumbGen.GenerateForPost(category, post.Title); err == nil {
+		thumbnailPath = path
+		defer os.Remove(path)
+	} else {
+		log.Warn("⚠️ 썸네일 생성 실패", "err", err)
+	}
+
+	dir, err := preview.Write(previewDir, preview.Meta{
+		Account:        acc.Name,
+		BlogName:       acc.Tistory.BlogName,
+		Category:       category,
+		PostCategory:   post.Category,
+		MappedCategory: acc.GetCategoryName(post.Category),
+		Title:          post.Title,
+		Tags:           post.Tags,
+		SourceIDs:      post.SourceIDs,
+		GeneratedAt:    post.CreatedAt,
+	}, post.Content, thumbnailPath)
+	if err != nil {
+		log.Error("❌ 미리보기 저장 실패", "err", err)
+		return
+	}
+
+	log.Info("🔍 미리보기 저장", "dir", dir)
+}
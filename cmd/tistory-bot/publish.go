@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/queue"
+	"github.com/Song-wh/tistory-bot/internal/tistory"
+)
+
+// 발행 옵션 플래그 (post 명령)
+var (
+	publishVisibility string
+	publishPassword   string
+	publishDraft      bool
+	publishReserve    string
+)
+
+// publishOptionsFromFlags post 명령 플래그로 발행 옵션 생성
+func publishOptionsFromFlags() (tistory.PublishOptions, error) {
+	visibility, err := tistory.ParseVisibility(publishVisibility)
+	if err != nil {
+		return tistory.PublishOptions{}, err
+	}
+
+	opts := tistory.PublishOptions{
+		Draft:      publishDraft,
+		Visibility: visibility,
+		Password:   publishPassword,
+	}
+
+	if publishReserve != "" {
+		at, err := time.ParseInLocation("2006-01-02 15:04", publishReserve, time.Local)
+		if err != nil {
+			return opts, fmt.Errorf("예약 시각 형식 오류 (예: \"2025-01-02 09:30\"): %w", err)
+		}
+		opts.ReserveAt = at
+	}
+
+	return opts, opts.Validate()
+}
+
+// publishOptionsFromConfig 스케줄 작업 설정으로 발행 옵션 생성 (예약 시각은 now 기준)
+func publishOptionsFromConfig(pc *config.PublishConfig, now time.Time) (tistory.PublishOptions, error) {
+	if pc == nil {
+		return tistory.PublishOptions{}, nil
+	}
+
+	var opts tistory.PublishOptions
+	switch pc.Mode {
+	case "draft":
+		opts.Draft = true
+	default:
+		visibility, err := tistory.ParseVisibility(pc.Mode)
+		if err != nil {
+			return opts, err
+		}
+		opts.Visibility = visibility
+		opts.Password = pc.Password
+	}
+
+	if pc.ReserveDelay != "" {
+		delay, err := time.ParseDuration(pc.ReserveDelay)
+		if err != nil {
+			return opts, fmt.Errorf("reserve_delay 형식 오류: %w", err)
+		}
+		// 티스토리 예약은 분 단위
+		opts.ReserveAt = now.Add(delay).Truncate(time.Minute).Add(time.Minute)
+	}
+
+	return opts, opts.Validate()
+}
+
+// publishErrorHint 발행 실패 유형별 안내
+func publishErrorHint(err error) string {
+	switch {
+	case errors.Is(err, tistory.ErrSessionExpired):
+		return "세션 만료, 다음 발행 시 다시 로그인합니다 (캡챠가 뜨면 login 명령으로 수동 로그인)"
+	case errors.Is(err, tistory.ErrCategoryNotFound):
+		return "config.yaml의 카테고리 매핑과 블로그 카테고리 이름을 확인하세요"
+	case errors.Is(err, tistory.ErrPublishButtonNotFound):
+		return "발행 레이어 구조가 바뀌었을 수 있습니다. --draft 로 임시저장 후 직접 발행하세요"
+	case errors.Is(err, tistory.ErrPublishNotConfirmed):
+		return "발행 여부 불명, 관리 페이지(posts list)에서 확인하세요"
+	}
+	return ""
+}
+
+// classifyPublishError 다시 시도하면 중복 발행되거나 설정을 고쳐야 하는 에러는 재시도하지 않음
+func classifyPublishError(err error) error {
+	switch {
+	case errors.Is(err, tistory.ErrPublishNotConfirmed), errors.Is(err, tistory.ErrCategoryNotFound):
+		return queue.Permanent(err)
+	}
+	return err
+}
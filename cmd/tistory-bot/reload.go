@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/queue"
+	"github.com/Song-wh/tistory-bot/internal/worker"
+	"github.com/robfig/cron/v3"
+)
+
+// defaultReloadInterval 설정 파일 변경 확인 간격
+const defaultReloadInterval = 5 * time.Second
+
+// liveConfig 스케줄러 실행 중 다시 읽을 수 있는 현재 설정
+// 클라이언트 공용 값(셀렉터 프로필, 이미지 캐시)도 설정과 같은 잠금 안에서 함께 교체한다.
+type liveConfig struct {
+	mu  sync.Mutex
+	cfg *config.Config
+}
+
+// Get 현재 설정
+func (l *liveConfig) Get() *config.Config {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.cfg
+}
+
+// Set 설정과 클라이언트 공용 값 교체
+func (l *liveConfig) Set(cfg *config.Config, deps clientDeps) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cfg = cfg
+	setDeps(deps)
+}
+
+// cronEntries 계정별 cron 항목 (설정을 다시 읽을 때 계정 단위로 교체)
+type cronEntries struct {
+	ctx context.Context // 트리거 로그용
+	c   *cron.Cron
+	q   *queue.Queue
+	m   map[string][]cron.EntryID
+}
+
+// newCronEntries cron 스케줄러에 계정 작업을 등록/해제하는 관리자
+func newCronEntries(ctx context.Context, c *cron.Cron, q *queue.Queue) *cronEntries {
+	return &cronEntries{ctx: ctx, c: c, q: q, m: map[string][]cron.EntryID{}}
+}
+
+// Add 계정의 스케줄 작업 등록 (이미 등록돼 있으면 먼저 해제)
+func (e *cronEntries) Add(acc config.AccountConfig) {
+	e.Remove(acc.Name)
+	log := logging.From(e.ctx).With(logging.KeyAccount, acc.Name)
+	if !acc.Schedule.Enabled || len(acc.Schedule.Jobs) == 0 {
+		log.Info("⏭️ 스케줄 비활성화")
+		return
+	}
+
+	for _, job := range acc.Schedule.Jobs {
+		log := log.With(logging.KeyCategory, job.Category)
+		if job.Publish != nil {
+			log.Info("📅 스케줄 등록", "cron", job.Cron, "publish", job.Publish.Mode)
+		} else {
+			log.Info("📅 스케줄 등록", "cron", job.Cron)
+		}
+
+		acc, job := acc, job // 클로저용 복사
+		id, err := e.c.AddFunc(job.Cron, func() {
+			enqueueTriggered(e.ctx, e.q, &acc, job, time.Now())
+		})
+		if err != nil {
+			log.Error("❌ cron 표현식 오류", "err", err)
+			continue
+		}
+		e.m[acc.Name] = append(e.m[acc.Name], id)
+	}
+}
+
+// Remove 계정의 스케줄 작업 해제 (이미 큐에 등록된 작업은 그대로)
+func (e *cronEntries) Remove(name string) {
+	for _, id := range e.m[name] {
+		e.c.Remove(id)
+	}
+	delete(e.m, name)
+}
+
+// startClient 계정 브라우저를 열고 로그인해 등록 (실패하면 닫고 false)
+func startClient(ctx context.Context, cfg *config.Config, acc *config.AccountConfig) bool {
+	ctx = logging.With(ctx, logging.KeyAccount, acc.Name)
+	client := newAccountClient(cfg, acc)
+	if err := client.Login(ctx); err != nil {
+		logging.From(ctx).Error("❌ 로그인 실패", "err", err)
+		notifyLoginFailed(ctx, acc.Name, err)
+		client.Close()
+		return false
+	}
+	clients.Set(acc.Name, client)
+	logging.From(ctx).Info("✅ 브라우저 준비 완료")
+	return true
+}
+
+// stopClient 계정 브라우저를 닫고 등록 해제 (없으면 무시)
+func stopClient(ctx context.Context, name string) {
+	client, ok := clients.Remove(name)
+	if !ok {
+		return
+	}
+	log := logging.From(ctx).With(logging.KeyAccount, name)
+	if err := client.Close(); err != nil {
+		log.Warn("⚠️ 브라우저 종료 실패", "err", err)
+		return
+	}
+	log.Info("🔒 브라우저 종료")
+}
+
+// watchConfig 설정 파일 변경(interval마다 확인)과 SIGHUP을 기다려 설정을 다시 읽음 (ctx가 끝나면 반환)
+// interval이 0이면 SIGHUP만 받는다.
+func watchConfig(ctx context.Context, interval time.Duration, reload func()) {
+	log := logging.From(ctx)
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	last, _ := os.Stat(cfgFile)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			log.Info("🔄 SIGHUP 수신, 설정 다시 읽는 중...")
+			last, _ = os.Stat(cfgFile)
+			reload()
+		case <-tick:
+			fi, err := os.Stat(cfgFile)
+			if err != nil || (last != nil && fi.ModTime().Equal(last.ModTime()) && fi.Size() == last.Size()) {
+				continue
+			}
+			last = fi
+			log.Info("🔄 설정 파일 변경 감지, 다시 읽는 중...")
+			reload()
+		}
+	}
+}
+
+// reloadConfig 설정을 다시 읽어 바뀐 계정만 적용 (잘못된 설정이면 기존 스케줄 유지)
+// 계정 브라우저를 교체할 때는 그 계정의 실행 중인 포스팅이 끝날 때까지 기다린다.
+func reloadConfig(ctx context.Context, live *liveConfig, entries *cronEntries, pool *worker.Pool) {
+	log := logging.From(ctx)
+	old := live.Get()
+	// 검증이 모두 끝난 뒤에 교체 (잘못된 설정이 일부만 적용되지 않도록)
+	cfg, deps, err := readConfig()
+	if err == nil {
+		err = notifier.Configure(cfg.Notify)
+	}
+	if err != nil {
+		log.Error("❌ 설정 다시 읽기 실패, 기존 스케줄 유지", "err", err)
+		return
+	}
+	changes := config.Diff(old, cfg)
+	live.Set(cfg, deps)
+	if changes.Empty() {
+		log.Info("✅ 설정 다시 읽음 (계정/스케줄 변경 없음)")
+		return
+	}
+
+	account := func(name string) *config.AccountConfig {
+		for _, acc := range cfg.GetEnabledAccounts() {
+			if acc.Name == name {
+				return &acc
+			}
+		}
+		return nil
+	}
+
+	for _, name := range changes.Removed {
+		log.Info("➖ 계정 제외", logging.KeyAccount, name)
+		entries.Remove(name)
+		release := pool.Hold(name)
+		stopClient(ctx, name)
+		release()
+	}
+	for _, name := range changes.Relogin {
+		log.Info("🔁 로그인/브라우저 설정 변경, 브라우저 다시 시작", logging.KeyAccount, name)
+		entries.Remove(name)
+		release := pool.Hold(name)
+		stopClient(ctx, name)
+		acc := account(name)
+		startClient(ctx, cfg, acc)
+		release()
+		entries.Add(*acc)
+	}
+	for _, name := range changes.Added {
+		log.Info("➕ 계정 추가", logging.KeyAccount, name)
+		acc := account(name)
+		startClient(ctx, cfg, acc)
+		entries.Add(*acc)
+	}
+	for _, name := range changes.Rescheduled {
+		log.Info("📅 스케줄 변경", logging.KeyAccount, name)
+		entries.Add(*account(name))
+	}
+	for _, name := range changes.Updated {
+		log.Info("📝 설정 변경 (다음 포스팅부터 적용)", logging.KeyAccount, name)
+	}
+	for _, path := range changes.Restart {
+		log.Warn("⚠️ 스케줄러를 다시 시작해야 적용되는 설정 변경", "path", path)
+	}
+	log.Info("✅ 설정 다시 읽기 완료")
+}
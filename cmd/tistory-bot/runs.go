@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/collector"
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/runs"
+	"github.com/Song-wh/tistory-bot/internal/tistory"
+)
+
+// 실행 기록 저장소 (프로세스당 1개, 스케줄러 고루틴 간 공유)
+var (
+	runsOnce  sync.Once
+	runsStore *runs.Store
+)
+
+// getRunStore 실행 기록 저장소 반환
+func getRunStore(cfg *config.Config) *runs.Store {
+	runsOnce.Do(func() {
+		runsStore = runs.NewStore(cfg.Runs.Dir)
+	})
+	return runsStore
+}
+
+// runRecorder 실행 1건의 기록 (beginRun으로 시작, finish로 저장)
+type runRecorder struct {
+	rec       runs.Record
+	trace     *collector.Trace
+	onPublish func(title string, baseline int) error // 발행 버튼 클릭 직전 호출 (스케줄 작업은 큐에 제목/baseline 기록)
+}
+
+// beginRun 실행 기록 시작 (반환된 ctx로 수집해야 API/시뮬레이션 사용이 기록됨)
+func beginRun(ctx context.Context, trigger, account, category string) (context.Context, *runRecorder) {
+	ctx, trace := collector.WithTrace(ctx)
+	return ctx, &runRecorder{
+		rec: runs.Record{
+			Trigger:   trigger,
+			Account:   account,
+			Category:  category,
+			StartedAt: time.Now(),
+		},
+		trace: trace,
+	}
+}
+
+// published 발행/임시저장 결과 기록
+func (r *runRecorder) published(result *tistory.PostResult) {
+	r.rec.PostID = result.PostID
+	r.rec.URL = result.URL
+	r.rec.Cover = result.Cover.Source
+	r.rec.Status = runs.StatusPublished
+	if result.Draft {
+		r.rec.Status = runs.StatusDraft
+	}
+}
+
+// publishing 발행 직전 알림 (기록하지 못하면 중단 후 발행 여부를 확인할 수 없으므로 발행하지 않음)
+func (r *runRecorder) publishing(title string, baseline int) error {
+	if r.onPublish == nil {
+		return nil
+	}
+	if err := r.onPublish(title, baseline); err != nil {
+		return fmt.Errorf("발행 시작 기록 실패: %w", err)
+	}
+	return nil
+}
+
+// finish 결과를 정해 저장 (err가 있으면 실패/중단, 결과가 없으면 건너뜀)
+func (r *runRecorder) finish(ctx context.Context, cfg *config.Config, err error) {
+	r.rec.EndedAt = time.Now()
+	r.rec.Fetched = r.trace.Fetched()
+	r.rec.Simulated = r.trace.Simulated()
+	r.rec.Errors = errorChain(err)
+	switch {
+	case errors.Is(err, context.Canceled):
+		r.rec.Status = runs.StatusCanceled
+	case err != nil:
+		r.rec.Status = runs.StatusFailed
+	case r.rec.Status == "":
+		r.rec.Status = runs.StatusSkipped
+	}
+	if err := getRunStore(cfg).Add(r.rec); err != nil {
+		logging.From(ctx).Warn("⚠️ 실행 기록 저장 실패", "err", err)
+	}
+}
+
+// errorChain 감싼 에러를 바깥 → 원인 순서의 메시지로 분리 ("발행 실패: 세션 만료" → ["발행 실패", "세션 만료"])
+func errorChain(err error) []string {
+	var chain []string
+	for err != nil {
+		msg := err.Error()
+		next := errors.Unwrap(err)
+		if next != nil {
+			msg = strings.TrimSuffix(msg, next.Error())
+			msg = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(msg), ":"))
+		}
+		if msg != "" {
+			chain = append(chain, msg)
+		}
+		err = next
+	}
+	return chain
+}
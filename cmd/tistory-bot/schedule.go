@@ -0,0 +1,499 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/humanize"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/metrics"
+	"github.com/Song-wh/tistory-bot/internal/queue"
+	"github.com/Song-wh/tistory-bot/internal/runs"
+	"github.com/Song-wh/tistory-bot/internal/tistory"
+	"github.com/Song-wh/tistory-bot/internal/worker"
+	"github.com/robfig/cron/v3"
+	"github.com/spf13/cobra"
+)
+
+// dispatchPoll 실행할 작업이 있지만 모든 계정 워커가 바쁠 때 다시 확인하는 간격
+const dispatchPoll = 15 * time.Second
+
+// queueRetention 끝난 작업을 큐 파일에 남겨두는 기간
+const queueRetention = 7 * 24 * time.Hour
+
+var schedulePlan bool // --plan: schedule plan과 같음 (표, 7일)
+
+var reloadInterval time.Duration // --reload-interval: 설정 파일 변경 확인 간격 (0 = SIGHUP만)
+
+// schedule 명령어 - 자동 스케줄 실행
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "자동 스케줄러 실행 (모든 계정)",
+	Long: `설정된 스케줄에 따라 자동으로 포스팅합니다.
+모든 활성화된 계정에 대해 각각의 스케줄을 실행합니다.
+
+cron 트리거는 작업을 큐(queue.dir)에 등록하고, 워커가 실행 시각이 된 작업을 처리합니다.
+실패한 작업은 지수 백오프로 재시도하고(queue.max_attempts), 재시작하면
+꺼져 있는 동안 놓친 작업을 카테고리별 허용 기간(queue.catch_up) 안에서 실행합니다.
+프로그램을 종료하려면 Ctrl+C를 누르세요. 실행 중인 포스팅은 queue.drain_timeout까지
+기다린 뒤 취소하며, Ctrl+C를 한 번 더 누르면 즉시 종료합니다.
+
+실제 발행 시각은 트리거 시각에 계정/작업별 humanize 규칙(랜덤 지연, 조용한 시간대,
+하루 상한, 최소 간격)을 적용해 정해집니다. 발행 계획은 schedule plan으로 확인하세요.
+
+실행 중에 설정 파일을 고치면(또는 SIGHUP) 다시 읽어 바뀐 계정만 적용합니다.
+cron/humanize 변경은 스케줄만 다시 등록하고, 로그인 정보나 browser 설정이 바뀐 계정만
+브라우저를 다시 시작합니다. 잘못된 설정은 거부하고 기존 스케줄을 그대로 유지합니다.
+
+--metrics-addr를 지정하면 /healthz(계정별 브라우저/로그인 상태, 마지막 성공 후 지난 시간)와
+/metrics(Prometheus 지표)를 제공합니다.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := loadConfig()
+		if err != nil {
+			fmt.Printf("설정 로드 실패: %v\n", err)
+			exit(1)
+		}
+
+		if schedulePlan {
+			if err := runPlan(getTargetAccounts(cfg), time.Now(), defaultPlanDays, "table", ""); err != nil {
+				fmt.Printf("❌ %v\n", err)
+				exit(1)
+			}
+			return
+		}
+
+		accounts := cfg.GetEnabledAccounts()
+		if len(accounts) == 0 {
+			fmt.Println("❌ 활성화된 계정이 없습니다.")
+			exit(1)
+		}
+
+		q, err := openQueue(cfg)
+		if err != nil {
+			fmt.Printf("❌ 작업 큐 열기 실패: %v\n", err)
+			exit(1)
+		}
+
+		ctx := cmd.Context()
+		log := logging.From(ctx)
+		log.Info("🚀 티스토리 자동 포스팅 스케줄러 시작!", "accounts", len(accounts))
+
+		// 이전 실행에서 끝나지 못한 작업 복구 + 놓친 작업 등록
+		now := time.Now()
+		recoverJobs(ctx, cfg, q, now)
+		catchUpMissed(ctx, cfg, q, accounts, now)
+		if n, err := q.Prune(now.Add(-queueRetention)); err == nil && n > 0 {
+			log.Info("🧹 오래된 작업 기록 정리", "jobs", n)
+		}
+
+		live := &liveConfig{cfg: cfg}
+		if metricsAddr != "" {
+			if err := serveHealth(ctx, metricsAddr, live, q); err != nil {
+				fmt.Printf("❌ 헬스 체크 서버 실행 실패: %v\n", err)
+				exit(1)
+			}
+		}
+
+		// 각 계정별로 브라우저 열고 로그인 (한 번만, 설정을 다시 읽을 때는 바뀐 계정만)
+		log.Info("🔐 계정별 브라우저 초기화 중...")
+		for _, acc := range accounts {
+			startClient(ctx, cfg, &acc)
+		}
+
+		c := cron.New()
+		entries := newCronEntries(ctx, c, q)
+		for _, acc := range accounts {
+			entries.Add(acc)
+		}
+
+		// 생존 시각 기록 (재시작 시 놓친 작업 계산 기준)
+		if err := q.Tick(time.Now()); err != nil {
+			log.Warn("⚠️ 큐 저장 실패", "err", err)
+		}
+		c.AddFunc("@every 1m", func() {
+			if err := q.Tick(time.Now()); err != nil {
+				log.Warn("⚠️ 큐 저장 실패", "err", err)
+			}
+		})
+		if cfg.Notify != nil && cfg.Notify.DailySummary != "" {
+			c.AddFunc(cfg.Notify.DailySummary, func() {
+				notifier.Notify(ctx, notifier.Summary(time.Now()))
+			})
+		}
+
+		log.Info("⏳ 스케줄 대기 중... (종료: Ctrl+C)")
+		if reloadInterval > 0 {
+			log.Info("🔄 설정 파일 변경 시 자동 적용 (SIGHUP으로 즉시)", "interval", reloadInterval.String())
+		}
+
+		c.Start()
+
+		// 계정마다 전용 워커 (같은 브라우저를 동시에 조작하지 않도록 계정 안에서는 1개씩)
+		// 작업 컨텍스트는 종료 신호로 바로 취소되지 않음 (drain_timeout까지 발행 마무리)
+		jobCtx, cancelJobs := context.WithCancel(context.WithoutCancel(ctx))
+		defer cancelJobs()
+
+		pool := worker.NewPool(cfg.Queue.Concurrency, accountMinGap(live))
+		stop := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dispatchJobs(jobCtx, live, q, pool, stop)
+		}()
+
+		// 설정 파일 변경 / SIGHUP → 바뀐 계정만 스케줄·브라우저 교체
+		reloaded := make(chan struct{})
+		go func() {
+			defer close(reloaded)
+			watchConfig(ctx, reloadInterval, func() { reloadConfig(ctx, live, entries, pool) })
+		}()
+
+		<-ctx.Done()
+		// 두 번째 Ctrl+C는 기본 동작(즉시 종료)
+		signal.Reset(os.Interrupt, syscall.SIGTERM)
+
+		log.Info("🛑 스케줄러 종료...")
+		c.Stop()
+		close(stop)
+		wg.Wait()
+
+		drainTimeout, _ := live.Get().Queue.DrainTimeoutDuration() // 설정 로드 시 검증됨
+		log.Info("⏳ 실행 중인 작업 완료 대기...", "timeout", drainTimeout.String())
+		drained := make(chan struct{})
+		go func() {
+			pool.Close()
+			close(drained)
+		}()
+		timer := time.NewTimer(drainTimeout)
+		select {
+		case <-drained:
+			timer.Stop()
+		case <-timer.C:
+			log.Warn("⌛ 대기 시간 초과, 실행 중인 작업 취소 (다음 실행 때 다시 시도)")
+			cancelJobs()
+			<-drained
+		}
+		if err := q.Tick(time.Now()); err != nil {
+			log.Warn("⚠️ 큐 저장 실패", "err", err)
+		}
+		<-reloaded // 진행 중이던 설정 다시 읽기가 브라우저를 새로 열지 않도록
+
+		// 모든 브라우저 닫기
+		log.Info("🔒 브라우저 종료 중...")
+		clients.CloseAll(func(name string, err error) {
+			if err != nil {
+				log.Warn("⚠️ 브라우저 종료 실패", logging.KeyAccount, name, "err", err)
+				return
+			}
+			log.Info("✅ 브라우저 종료", logging.KeyAccount, name)
+		})
+	},
+}
+
+// openQueue 설정의 큐 디렉토리/재시도 정책으로 작업 큐 열기
+func openQueue(cfg *config.Config) (*queue.Queue, error) {
+	base, max, err := cfg.Queue.RetryDelays()
+	if err != nil {
+		return nil, err
+	}
+	return queue.Open(cfg.Queue.Dir, queue.RetryPolicy{
+		MaxAttempts: cfg.Queue.MaxAttempts,
+		BaseDelay:   base,
+		MaxDelay:    max,
+	})
+}
+
+// enqueueTriggered cron 트리거 시 humanize 규칙으로 계산한 실행 시각에 작업 등록
+func enqueueTriggered(ctx context.Context, q *queue.Queue, acc *config.AccountConfig, job config.ScheduleJob, now time.Time) {
+	log := logging.From(ctx).With(logging.KeyAccount, acc.Name, logging.KeyCategory, job.Category)
+	slot, err := humanize.SlotFor(acc, job, now.Truncate(time.Minute))
+	if err != nil {
+		log.Error("❌ 실행 시각 계산 실패", "err", err)
+		return
+	}
+	if slot.Skipped != "" {
+		log.Info("⏭️ 스케줄 건너뜀", "reason", slot.Skipped)
+		return
+	}
+
+	queued, added, err := q.Enqueue(queue.Job{
+		Account:     acc.Name,
+		Category:    job.Category,
+		Publish:     job.Publish,
+		ScheduledAt: slot.TriggerAt,
+		DueAt:       slot.RunAt,
+	}, now)
+	if err != nil {
+		log.Error("❌ 작업 등록 실패", "err", err)
+		return
+	}
+	if !added {
+		return
+	}
+	log.Info("⏰ 스케줄 트리거", logging.KeyJob, queued.ID, "due_at", queued.DueAt.Format("01/02 15:04"))
+}
+
+// catchUpMissed 스케줄러가 꺼져 있는 동안 놓친 작업 등록 (카테고리별 허용 기간 안의 가장 최근 1회)
+func catchUpMissed(ctx context.Context, cfg *config.Config, q *queue.Queue, accounts []config.AccountConfig, now time.Time) {
+	lastTick := q.LastTick()
+	if lastTick.IsZero() {
+		return
+	}
+
+	for _, acc := range accounts {
+		if !acc.Schedule.Enabled {
+			continue
+		}
+		for _, job := range acc.Schedule.Jobs {
+			window, err := cfg.Queue.CatchUpWindow(job.Category)
+			if err != nil || window == 0 {
+				continue
+			}
+			since := lastTick
+			if cutoff := now.Add(-window); cutoff.After(since) {
+				since = cutoff
+			}
+			missed, ok := lastMissedRun(job.Cron, since, now)
+			if !ok {
+				continue
+			}
+
+			// 조용한 시간대면 끝난 뒤에 실행
+			dueAt := now
+			if rules, err := humanize.RulesFor(&acc, &job, now.Weekday()); err == nil {
+				dueAt = rules.AvoidQuiet(now)
+			}
+			log := logging.From(ctx).With(logging.KeyAccount, acc.Name, logging.KeyCategory, job.Category)
+			queued, added, err := q.Enqueue(queue.Job{
+				Account:     acc.Name,
+				Category:    job.Category,
+				Publish:     job.Publish,
+				ScheduledAt: missed,
+				DueAt:       dueAt,
+				CatchUp:     true,
+			}, now)
+			if err != nil {
+				log.Error("❌ 놓친 작업 등록 실패", "err", err)
+				continue
+			}
+			if added {
+				log.Info("⏪ 놓친 작업 등록", logging.KeyJob, queued.ID, "scheduled_at", missed.Format("01/02 15:04"))
+			}
+		}
+	}
+}
+
+// recoverJobs 이전 실행에서 실행 중이던 작업 복구
+// 놓친 작업과 같은 catch_up 기간이 지난 작업은 다시 실행하지 않고, 발행을 시작했던 작업은 발행 여부를 확인한 뒤 실행한다.
+func recoverJobs(ctx context.Context, cfg *config.Config, q *queue.Queue, now time.Time) {
+	log := logging.From(ctx)
+	requeued, expired, err := q.Recover(now, func(job queue.Job) time.Duration {
+		window, _ := cfg.Queue.CatchUpWindow(job.Category) // 설정 로드 시 검증됨
+		return window
+	})
+	if err != nil {
+		log.Warn("⚠️ 중단된 작업 복구 실패", "err", err)
+		return
+	}
+	for _, job := range requeued {
+		log := log.With(logging.KeyAccount, job.Account, logging.KeyCategory, job.Category, logging.KeyJob, job.ID)
+		if job.Verify {
+			log.Info("♻️ 발행 도중 중단된 작업, 발행 여부 확인 후 다시 실행", "title", job.Title)
+		} else {
+			log.Info("♻️ 중단된 작업 다시 대기열에 등록")
+		}
+	}
+	for _, job := range expired {
+		log.With(logging.KeyAccount, job.Account, logging.KeyCategory, job.Category, logging.KeyJob, job.ID).
+			Warn("⏭️ 중단된 작업이 허용 기간을 지나 다시 실행하지 않음", "due_at", job.DueAt.Format("01/02 15:04"), "title", job.Title)
+	}
+}
+
+// lastMissedRun (since, now] 구간에서 cron이 마지막으로 트리거됐어야 할 시각
+func lastMissedRun(expr string, since, now time.Time) (time.Time, bool) {
+	sched, err := cron.ParseStandard(expr)
+	if err != nil {
+		return time.Time{}, false
+	}
+	var last time.Time
+	for t := sched.Next(since); !t.After(now); t = sched.Next(t) {
+		last = t
+	}
+	return last, !last.IsZero()
+}
+
+// dispatchJobs 실행 시각이 된 작업을 계정별 워커에 전달 (stop이 닫히면 반환)
+// 계정 워커가 바쁘거나 최소 간격(min_gap)이 지나지 않은 계정의 작업은 큐에 남겨 둔다.
+func dispatchJobs(ctx context.Context, live *liveConfig, q *queue.Queue, pool *worker.Pool, stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		now := time.Now()
+		job, err := q.ClaimFunc(now, func(job queue.Job) bool {
+			return pool.Ready(job.Account, now)
+		})
+		if err != nil {
+			logging.From(ctx).Error("❌ 작업 꺼내기 실패", "err", err)
+		}
+		if job != nil {
+			cfg := live.Get() // 다시 읽은 설정은 다음에 꺼내는 작업부터 적용
+			if !pool.Submit(job.Account, func() { executeJob(ctx, cfg, q, job) }) {
+				// 종료 중이거나 그 사이 워커가 바빠짐 → 재시작/다음 회차에 다시 실행
+				q.Release(job.ID, time.Now())
+			}
+			continue
+		}
+
+		// 다음 실행 예정 작업까지 대기 (새 작업 등록, 워커 작업 종료 시 깨어남)
+		wait := time.Minute
+		if next, ok := q.NextDue(); ok {
+			if d := time.Until(next); d < wait {
+				wait = d
+			}
+			if wait <= 0 {
+				wait = dispatchPoll // 실행 시각이 됐지만 계정 워커가 바쁨
+			}
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-q.Wakeup():
+			timer.Stop()
+		case <-pool.Idle():
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// accountMinGap 계정별 포스팅 최소 간격 조회 함수 (현재 설정 기준)
+func accountMinGap(live *liveConfig) func(account string) time.Duration {
+	return func(account string) time.Duration {
+		for _, acc := range live.Get().Accounts {
+			if acc.Name == account {
+				gap, _ := acc.Schedule.MinGapDuration() // 설정 로드 시 검증됨
+				return gap
+			}
+		}
+		return config.DefaultMinGap
+	}
+}
+
+// executeJob 큐 작업 1건 실행 후 완료/재시도/실패 기록
+// 작업의 모든 로그에 계정/카테고리/작업 ID를 붙인다.
+func executeJob(ctx context.Context, cfg *config.Config, q *queue.Queue, job *queue.Job) {
+	ctx = logging.With(ctx, logging.KeyAccount, job.Account, logging.KeyCategory, job.Category, logging.KeyJob, job.ID)
+	ctx, run := beginRun(ctx, runs.TriggerSchedule, job.Account, job.Category)
+	run.rec.Job = job.ID
+	run.rec.Attempt = job.Attempts
+	run.onPublish = func(title string, baseline int) error {
+		return q.Publishing(job.ID, title, baseline, time.Now())
+	}
+	log := logging.From(ctx)
+	if job.Attempts > 1 {
+		log.Info("▶️ 포스팅 시작", "retry", fmt.Sprintf("%d/%d", job.Attempts-1, cfg.Queue.MaxAttempts-1))
+	} else {
+		log.Info("▶️ 포스팅 시작")
+	}
+
+	err := runQueuedJob(ctx, cfg, job, run)
+	run.finish(ctx, cfg, err)
+	now := time.Now()
+	if err == nil {
+		if err := q.Complete(job.ID, now); err != nil {
+			log.Warn("⚠️ 작업 완료 기록 실패", "err", err)
+		}
+		return
+	}
+
+	// 종료로 취소된 작업은 시도 횟수에 넣지 않고 대기열로 되돌림
+	if errors.Is(err, context.Canceled) {
+		if err := q.Release(job.ID, now); err != nil {
+			log.Warn("⚠️ 작업 반환 실패", "err", err)
+			return
+		}
+		log.Info("⏸️ 작업 취소, 다음 실행 때 다시 시도")
+		return
+	}
+
+	observePost(job.Account, job.Category, metrics.ResultFailed)
+	retryAt, retry, qerr := q.Fail(job.ID, err, now)
+	switch {
+	case qerr != nil:
+		log.Warn("⚠️ 작업 실패 기록 실패", "err", qerr)
+	case retry:
+		log.Warn("🔁 재시도 예정", "retry_at", retryAt.Format("15:04:05"), "err", err)
+	default:
+		log.Error("🛑 작업 포기", "attempts", job.Attempts, "err", err)
+	}
+	// 재시도할 작업은 포기할 때만 알림 (로그인/캡챠는 사람이 봐야 하므로 바로)
+	if !retry || isLoginError(err) {
+		notifyFailed(ctx, job.Account, job.Category, run.rec.Title, err)
+	}
+}
+
+// runQueuedJob 작업의 계정/발행 옵션을 현재 설정에서 찾아 포스팅
+func runQueuedJob(ctx context.Context, cfg *config.Config, job *queue.Job, run *runRecorder) error {
+	var acc *config.AccountConfig
+	for _, a := range cfg.GetEnabledAccounts() {
+		if a.Name == job.Account {
+			a := a
+			acc = &a
+			break
+		}
+	}
+	if acc == nil {
+		return queue.Permanent(fmt.Errorf("활성 계정 없음: %s", job.Account))
+	}
+
+	// 발행 도중 중단된 작업은 이미 발행됐으면 다시 발행하지 않음
+	if job.Verify {
+		if job.Baseline < 0 {
+			// 발행 전 기존 글을 조회하지 못했으면 예전 글과 이번 글을 구분할 수 없음 → 중복 발행보다 포기
+			return queue.Permanent(fmt.Errorf("%w: 중단된 작업 '%s' (기존 글 조회 실패로 확인 불가, 관리 페이지에서 확인 필요)", tistory.ErrPublishNotConfirmed, job.Title))
+		}
+		post, err := findInterruptedPost(ctx, cfg, acc, job.Title, job.Baseline)
+		if err != nil {
+			return fmt.Errorf("중단된 작업의 발행 여부 확인 실패: %w", err)
+		}
+		if post != nil {
+			logging.From(ctx).Info("✅ 중단 전에 이미 발행된 작업, 다시 발행하지 않음", "title", post.Title, "url", post.URL)
+			run.rec.Title = post.Title
+			run.published(&tistory.PostResult{PostID: post.PostID, URL: post.URL})
+			return nil
+		}
+		logging.From(ctx).Info("🔍 중단된 작업이 발행되지 않음, 다시 실행", "title", job.Title)
+	}
+
+	opts, err := publishOptionsFromConfig(job.Publish, time.Now())
+	if err != nil {
+		logging.From(ctx).Error("❌ 발행 옵션 오류", "err", err)
+		return queue.Permanent(err)
+	}
+	return runPostForAccount(ctx, cfg, acc, job.Category, opts, run)
+}
+
+// findInterruptedPost 관리 목록에서 중단된 작업이 발행한 글 찾기 (없으면 nil)
+// 발행 직전에 기록한 baseline보다 새 글만 인정한다 (매일 같은 제목으로 발행하는 카테고리의 예전 글 제외).
+func findInterruptedPost(ctx context.Context, cfg *config.Config, acc *config.AccountConfig, title string, baseline int) (*tistory.PostSummary, error) {
+	client, exists := clients.Get(acc.Name)
+	if !exists {
+		client = newAccountClient(cfg, acc)
+		defer client.Close()
+	}
+	return client.FindPostAfter(ctx, title, baseline)
+}
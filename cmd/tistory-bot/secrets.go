@@ -0,0 +1,237 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/secrets"
+	"github.com/spf13/cobra"
+)
+
+var vaultFile string // secrets --vault (빈 값 = 설정의 secrets.vault)
+
+// secrets 명령어 - 암호화된 비밀 저장소 관리
+var secretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "비밀 저장소 관리 (비밀번호, API 키) 🔐",
+	Long: `설정 파일에 평문 대신 참조를 적을 수 있습니다.
+
+  password: "vault:kakao-main"      # 암호화된 비밀 저장소
+  api_key: "${TMDB_API_KEY}"        # 환경 변수
+  secret_key: "file:/run/secrets/coupang"  # 파일 내용
+
+비밀 저장소는 암호에서 만든 키(PBKDF2-SHA256)로 AES-256-GCM 암호화됩니다.
+암호는 ` + secrets.PassphraseEnv + ` 환경 변수 또는 터미널 입력으로 받습니다.`,
+}
+
+// secrets init 명령어
+var secretsInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "빈 비밀 저장소 생성",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		path := vaultPathFlag()
+		if secrets.Exists(path) {
+			fmt.Printf("❌ 비밀 저장소가 이미 있음: %s\n", path)
+			exit(1)
+		}
+		pass, err := newPassphrase()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+		if _, err := secrets.Create(path, pass); err != nil {
+			fmt.Printf("❌ 비밀 저장소 생성 실패: %v\n", err)
+			exit(1)
+		}
+		fmt.Printf("✅ 비밀 저장소 생성: %s\n", path)
+		fmt.Println("   다음: tistory-bot secrets set <이름>  →  설정에 \"vault:<이름>\"")
+	},
+}
+
+// secrets set 명령어
+var secretsSetCmd = &cobra.Command{
+	Use:   "set NAME",
+	Short: "비밀 값 저장 (값은 입력 프롬프트 또는 표준 입력으로)",
+	Long: `비밀 값을 저장합니다. 셸 기록에 남지 않도록 값은 인자로 받지 않습니다.
+
+  tistory-bot secrets set kakao-main            # 터미널에서 입력
+  printf '%s' "$KEY" | tistory-bot secrets set tmdb   # 표준 입력`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := args[0]
+		v := openVault()
+
+		value, err := readSecretValue(name)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+		if value == "" {
+			fmt.Println("❌ 값이 비어 있음")
+			exit(1)
+		}
+
+		_, existed := v.Get(name)
+		v.Set(name, value)
+		if err := v.Save(); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+		if existed {
+			fmt.Printf("✅ %s 값 변경\n", name)
+		} else {
+			fmt.Printf("✅ %s 저장 (설정에 \"vault:%s\")\n", name, name)
+		}
+	},
+}
+
+// secrets list 명령어
+var secretsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "저장된 비밀 이름 목록 (값은 표시하지 않음)",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		v := openVault()
+		names := v.Names()
+		fmt.Printf("🔐 %s: %d개\n", vaultPathFlag(), len(names))
+		for _, name := range names {
+			fmt.Printf("   • %s\n", name)
+		}
+	},
+}
+
+// secrets rm 명령어
+var secretsRmCmd = &cobra.Command{
+	Use:   "rm NAME",
+	Short: "비밀 값 삭제",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		v := openVault()
+		if !v.Delete(args[0]) {
+			fmt.Printf("❌ 저장소에 %s 없음\n", args[0])
+			exit(1)
+		}
+		if err := v.Save(); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+		fmt.Printf("🗑️ %s 삭제\n", args[0])
+	},
+}
+
+// secrets passwd 명령어
+var secretsPasswdCmd = &cobra.Command{
+	Use:   "passwd",
+	Short: "비밀 저장소 암호 변경",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		v := openVault()
+		if !secrets.IsTerminal() {
+			fmt.Println("❌ 새 암호는 터미널에서 입력해야 함")
+			exit(1)
+		}
+		pass, err := promptNewPassphrase()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+		if err := v.Rekey(pass); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+		if err := v.Save(); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			exit(1)
+		}
+		fmt.Println("✅ 암호 변경 완료")
+		if os.Getenv(secrets.PassphraseEnv) != "" {
+			fmt.Printf("   ⚠️ %s 환경 변수도 새 암호로 바꾸세요\n", secrets.PassphraseEnv)
+		}
+	},
+}
+
+// vaultPathFlag --vault 또는 설정 파일의 비밀 저장소 경로
+func vaultPathFlag() string {
+	if vaultFile != "" {
+		return vaultFile
+	}
+	return config.VaultPath(cfgFile)
+}
+
+// openVault 비밀 저장소 열기 (실패 시 종료)
+func openVault() *secrets.Vault {
+	path := vaultPathFlag()
+	if !secrets.Exists(path) {
+		fmt.Printf("❌ 비밀 저장소 없음: %s (tistory-bot secrets init)\n", path)
+		exit(1)
+	}
+	pass, err := secrets.Passphrase()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		exit(1)
+	}
+	v, err := secrets.Open(path, pass)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		exit(1)
+	}
+	return v
+}
+
+// newPassphrase 새 저장소 암호 (터미널이면 두 번 입력, 아니면 환경 변수)
+func newPassphrase() (string, error) {
+	if secrets.IsTerminal() {
+		return promptNewPassphrase()
+	}
+	if pass := os.Getenv(secrets.PassphraseEnv); pass != "" {
+		return pass, nil
+	}
+	return "", fmt.Errorf("암호 필요: 터미널에서 실행하거나 %s 환경 변수를 설정하세요", secrets.PassphraseEnv)
+}
+
+// promptNewPassphrase 새 암호를 두 번 입력받아 확인
+func promptNewPassphrase() (string, error) {
+	pass, err := secrets.Prompt("🔐 새 암호: ", true)
+	if err != nil {
+		return "", err
+	}
+	if len(pass) < 8 {
+		return "", errors.New("암호는 8자 이상이어야 함")
+	}
+	again, err := secrets.Prompt("🔐 새 암호 확인: ", true)
+	if err != nil {
+		return "", err
+	}
+	if pass != again {
+		return "", errors.New("암호가 일치하지 않음")
+	}
+	return pass, nil
+}
+
+// readSecretValue 저장할 값 (터미널이면 입력 프롬프트, 아니면 표준 입력 전체)
+func readSecretValue(name string) (string, error) {
+	if secrets.IsTerminal() {
+		return secrets.Prompt(fmt.Sprintf("🔑 %s 값: ", name), true)
+	}
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("표준 입력 읽기 실패: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// secretSource 계정 비밀 값의 출처 표시 (값 자체는 보여주지 않음)
+func secretSource(cfg *config.Config, account int, value string, path ...interface{}) string {
+	if value == "" {
+		return "❌ 미설정"
+	}
+	if ref := cfg.SecretRef(account, path...); ref != "" {
+		return "🔒 " + ref
+	}
+	return "⚠️ 평문 " + secrets.MaskValue(value) + " (vault:/${ENV}/file: 참조 권장)"
+}
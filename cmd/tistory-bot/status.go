@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/humanize"
+	"github.com/Song-wh/tistory-bot/internal/queue"
+	"github.com/Song-wh/tistory-bot/internal/runs"
+	"github.com/spf13/cobra"
+)
+
+// defaultStatusLimit status 기본 최근 실행 수 (계정별)
+const defaultStatusLimit = 10
+
+// statusWindows 성공률 집계 기간 (일)
+var statusWindows = []int{7, 30}
+
+var statusLimit int // --limit
+
+// status 명령어 - 실행 기록 요약
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "최근 실행 기록, 카테고리별 성공률, 다음 예정 실행 📊",
+	Long: `post / run / 스케줄 작업이 남긴 실행 기록(runs.dir)을 계정별로 요약합니다.
+
+- 최근 실행 N건: 결과, 소요 시간, 제목/URL 또는 오류, 시뮬레이션 데이터 사용 여부
+- 카테고리별 성공률: 최근 7일 / 30일 (발행 + 임시저장 / 건너뜀을 뺀 실행)
+- 다음 예정 실행: humanize 규칙을 적용한 다음 스케줄 회차와 큐에서 재시도를 기다리는 작업`,
+	Example: `  tistory-bot status
+  tistory-bot status --account my-blog --limit 20`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := loadConfig()
+		if err != nil {
+			fmt.Printf("설정 로드 실패: %v\n", err)
+			exit(1)
+		}
+
+		accounts := getTargetAccounts(cfg)
+		if len(accounts) == 0 {
+			fmt.Println("❌ 활성화된 계정이 없습니다.")
+			exit(1)
+		}
+		if statusLimit < 1 {
+			fmt.Printf("❌ --limit은 1 이상이어야 함: %d\n", statusLimit)
+			exit(1)
+		}
+
+		// 큐는 읽기만 함 (스케줄러가 실행 중이어도 안전)
+		var pending []queue.Job
+		if q, err := openQueue(cfg); err != nil {
+			fmt.Printf("⚠️ 작업 큐를 읽지 못함: %v\n", err)
+		} else {
+			for _, job := range q.List() {
+				if job.Status == queue.StatusPending {
+					pending = append(pending, job)
+				}
+			}
+		}
+
+		store := getRunStore(cfg)
+		now := time.Now()
+		for _, acc := range accounts {
+			if err := writeAccountStatus(os.Stdout, store, &acc, pending, now); err != nil {
+				fmt.Printf("❌ [%s] %v\n", acc.Name, err)
+				exit(1)
+			}
+		}
+	},
+}
+
+// writeAccountStatus 계정 1개의 다음 예정 실행 / 최근 실행 / 카테고리별 성공률
+func writeAccountStatus(w io.Writer, store *runs.Store, acc *config.AccountConfig, pending []queue.Job, now time.Time) error {
+	oldest := statusWindows[len(statusWindows)-1]
+	records, err := store.List(acc.Name, now.AddDate(0, 0, -oldest))
+	if err != nil {
+		return fmt.Errorf("실행 기록 조회 실패: %w", err)
+	}
+
+	fmt.Fprintf(w, "\n📊 [%s]\n", acc.Name)
+	fmt.Fprintln(w, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+
+	// 다음 예정 실행
+	slots, err := humanize.PlanAccount(acc, now, now.AddDate(0, 0, defaultPlanDays))
+	if err != nil {
+		return err
+	}
+	var next *humanize.Slot
+	for i := range slots {
+		s := &slots[i]
+		if s.Skipped == "" && s.RunAt.After(now) && (next == nil || s.RunAt.Before(next.RunAt)) {
+			next = s
+		}
+	}
+	if next != nil {
+		fmt.Fprintf(w, "⏰ 다음 실행: %s  %s\n", next.RunAt.Format("01/02 15:04"), next.Job.Category)
+	} else {
+		fmt.Fprintf(w, "⏰ 다음 실행: 앞으로 %d일 동안 없음\n", defaultPlanDays)
+	}
+	for _, job := range pending {
+		if job.Account != acc.Name {
+			continue
+		}
+		note := "대기"
+		switch {
+		case job.Attempts > 0:
+			note = fmt.Sprintf("재시도 (%d회 실패)", job.Attempts)
+		case job.CatchUp:
+			note = "놓친 작업"
+		}
+		fmt.Fprintf(w, "⏳ 큐: %s  %-14s %s\n", job.DueAt.Format("01/02 15:04"), job.Category, note)
+	}
+
+	// 최근 실행 (최신 순)
+	if len(records) == 0 {
+		fmt.Fprintf(w, "\n📭 최근 %d일 실행 기록 없음\n", oldest)
+		return nil
+	}
+	recent := records
+	if len(recent) > statusLimit {
+		recent = recent[len(recent)-statusLimit:]
+	}
+	fmt.Fprintf(w, "\n🕘 최근 실행 %d건\n", len(recent))
+	for i := len(recent) - 1; i >= 0; i-- {
+		writeRunLine(w, &recent[i])
+	}
+
+	// 카테고리별 성공률
+	fmt.Fprintf(w, "\n📈 카테고리별 성공률 (%s)\n", windowLabel())
+	var categories []string
+	byWindow := make([]map[string]runs.Stats, len(statusWindows))
+	for i, days := range statusWindows {
+		byWindow[i] = make(map[string]runs.Stats)
+		for _, st := range runs.CategoryStats(records, now.AddDate(0, 0, -days)) {
+			byWindow[i][st.Category] = st
+			if !contains(categories, st.Category) {
+				categories = append(categories, st.Category)
+			}
+		}
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		cols := make([]string, len(statusWindows))
+		for i := range statusWindows {
+			st := byWindow[i][category]
+			cols[i] = formatRate(&st)
+		}
+		fmt.Fprintln(w, strings.TrimRight(fmt.Sprintf("  %-14s %s", category, strings.Join(cols, "   ")), " "))
+	}
+	return nil
+}
+
+// writeRunLine 실행 기록 1줄 (+ 오류/시뮬레이션 데이터 줄)
+func writeRunLine(w io.Writer, rec *runs.Record) {
+	detail := rec.Title
+	if rec.URL != "" {
+		detail = strings.TrimSpace(detail + " " + rec.URL)
+	}
+	line := fmt.Sprintf("  %s %s  %-14s %-9s %-8s %s",
+		runStatusIcon(rec.Status), rec.StartedAt.Format("01/02 15:04"), rec.Category,
+		rec.Trigger, rec.Duration().Round(time.Second), detail)
+	fmt.Fprintln(w, strings.TrimRight(line, " "))
+	if len(rec.Errors) > 0 {
+		fmt.Fprintf(w, "      ↳ %s\n", strings.Join(rec.Errors, " → "))
+	}
+	if len(rec.Simulated) > 0 {
+		fmt.Fprintf(w, "      ↳ 시뮬레이션 데이터: %s\n", strings.Join(rec.Simulated, ", "))
+	}
+}
+
+// runStatusIcon 실행 결과 표시
+func runStatusIcon(status runs.Status) string {
+	switch status {
+	case runs.StatusPublished:
+		return "✅"
+	case runs.StatusDraft:
+		return "💾"
+	case runs.StatusSkipped:
+		return "⏭️"
+	case runs.StatusPreview:
+		return "👀"
+	case runs.StatusCanceled:
+		return "⏸️"
+	}
+	return "❌"
+}
+
+// windowLabel 성공률 열 제목 (예: "7일 / 30일")
+func windowLabel() string {
+	labels := make([]string, len(statusWindows))
+	for i, days := range statusWindows {
+		labels[i] = fmt.Sprintf("%d일", days)
+	}
+	return strings.Join(labels, " / ")
+}
+
+// formatRate 성공률 열 (예: "92% (11/12)", 시도가 없으면 "-")
+func formatRate(st *runs.Stats) string {
+	rate, ok := st.Rate()
+	if !ok {
+		return fmt.Sprintf("%-12s", "-")
+	}
+	return fmt.Sprintf("%-12s", fmt.Sprintf("%.0f%% (%d/%d)", rate*100, st.Success, st.Success+st.Failed))
+}
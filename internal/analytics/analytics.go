@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Song-wh/tistory-bot/internal/logging"
 	"github.com/go-rod/rod"
 	"github.com/go-rod/rod/lib/launcher"
 	"github.com/go-rod/rod/lib/proto"
@@ -171,7 +172,9 @@ func (a *Analyzer) CollectStats(ctx context.Context) ([]PostStats, error) {
 	}
 
 	// 통계 페이지로 이동
+	log := logging.From(ctx)
 	statsURL := fmt.Sprintf("https://%s.tistory.com/manage/posts", a.blogName)
+	log.Debug("📈 글 목록 페이지 로딩 중...", "url", statsURL)
 	page, err := a.browser.Page(proto.TargetCreateTarget{URL: statsURL})
 	if err != nil {
 		return nil, err
@@ -222,6 +225,7 @@ func (a *Analyzer) CollectStats(ctx context.Context) ([]PostStats, error) {
 
 	if err := json.Unmarshal([]byte(result.String()), &rawPosts); err != nil {
 		// 파싱 실패 시 시뮬레이션 데이터 반환
+		log.Warn("⚠️ 글 목록 파싱 실패, 시뮬레이션 데이터 사용", "err", err)
 		return a.GetSimulatedStats(), nil
 	}
 
@@ -236,11 +240,14 @@ func (a *Analyzer) CollectStats(ctx context.Context) ([]PostStats, error) {
 	}
 
 	if len(stats) == 0 {
+		log.Warn("⚠️ 수집된 글 없음, 시뮬레이션 데이터 사용")
 		return a.GetSimulatedStats(), nil
 	}
 
 	// 데이터 저장
-	a.saveStats(stats)
+	if err := a.saveStats(stats); err != nil {
+		log.Warn("⚠️ 통계 저장 실패", "err", err)
+	}
 
 	return stats, nil
 }
@@ -0,0 +1,124 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+)
+
+// -update: 재생 결과로 testdata/*/golden.html 갱신 (fixtures check --update와 같음)
+var update = flag.Bool("update", false, "골든 파일 갱신")
+
+// goldenEnv 재생용 수집 환경 (fixtures 명령의 fixtureEnv와 같은 고정 계정/키)
+func goldenEnv(dir string) *Env {
+	return &Env{
+		Config: &config.Config{TMDB: config.TMDBConfig{APIKey: "fixture"}},
+		Account: &config.AccountConfig{
+			Name:    "fixture",
+			Coupang: config.CoupangConfig{PartnerID: "AF0000000"},
+		},
+		HTTP: &ReplayDoer{Dir: filepath.Join(dir, "http")},
+	}
+}
+
+// replayBrowser 브라우저 수집기 재생 (브라우저가 추출한 상품 목록 products.json → 포스트)
+func replayBrowser(t *testing.T, name, dir string, env *Env) *Post {
+	t.Helper()
+	if name != "coupang" {
+		t.Fatalf("재생 방법이 없는 브라우저 수집기: %s", name)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "products.json"))
+	if err != nil {
+		t.Fatalf("녹화 없음: %v", err)
+	}
+	var products []CoupangProduct
+	if err := json.Unmarshal(data, &products); err != nil {
+		t.Fatalf("products.json 파싱 실패: %v", err)
+	}
+	post := NewCoupangCollector(env.CoupangID()).GenerateCoupangPost(products)
+	if err := env.Render(post); err != nil {
+		t.Fatalf("렌더링 실패: %v", err)
+	}
+	return post
+}
+
+// TestCollectorsGolden 등록된 모든 수집기를 녹화 응답으로 재생해 golden.html과 비교
+func TestCollectorsGolden(t *testing.T) {
+	for _, src := range Sources() {
+		name := src.Name()
+		t.Run(name, func(t *testing.T) {
+			dir := filepath.Join("testdata", name)
+			data, err := os.ReadFile(filepath.Join(dir, "clock"))
+			if err != nil {
+				t.Fatalf("녹화 없음 (fixtures record %s): %v", name, err)
+			}
+			now, err := time.Parse(time.RFC3339, strings.TrimSpace(string(data)))
+			if err != nil {
+				t.Fatalf("녹화 시각 파싱 실패: %v", err)
+			}
+			SetClock(func() time.Time { return now })
+			t.Cleanup(func() { SetClock(nil) })
+
+			env := goldenEnv(dir)
+			var post *Post
+			if src.Meta().Browser {
+				post = replayBrowser(t, name, dir, env)
+			} else {
+				ctx, trace := WithTrace(context.Background())
+				post, err = src.Collect(ctx, env)
+				if err != nil {
+					t.Fatalf("재생 수집 실패: %v", err)
+				}
+				// 녹화 없는 요청은 ReplayDoer 에러 → 수집기가 시뮬레이션으로 넘어가므로 따로 확인
+				for _, fetched := range trace.Fetched() {
+					if strings.HasSuffix(fetched, " error") {
+						t.Errorf("녹화 없는 요청: %s", fetched)
+					}
+				}
+			}
+			got := RenderGolden(post)
+
+			goldenPath := filepath.Join(dir, "golden.html")
+			if *update {
+				if err := os.WriteFile(goldenPath, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("골든 파일 읽기 실패: %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("골든 파일 불일치 (의도한 변경이면 -update)\n%s", lineDiff(string(want), got))
+			}
+		})
+	}
+}
+
+// lineDiff 처음으로 다른 줄
+func lineDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("  %d행\n  - %s\n  + %s", i+1, w, g)
+		}
+	}
+	return ""
+}
@@ -3,11 +3,14 @@ package collector
 import (
 	"context"
 	"fmt"
+	"image/color"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 	"github.com/go-rod/rod"
 	"github.com/go-rod/rod/lib/launcher"
 	"github.com/go-rod/rod/lib/proto"
@@ -47,6 +50,35 @@ func NewCoupangCollector(partnerID string) *CoupangCollector {
 	}
 }
 
+func init() {
+	Register(NewSource("coupang", SourceMeta{
+		Description:  "쿠팡 특가/파트너스 💰",
+		PostCategory: CategoryCoupang,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{230, 57, 70, 255}, // 쿠팡 레드
+			GradientEnd:   color.RGBA{168, 50, 62, 255}, // 다크레드
+			Emoji:         "DEAL",
+			SubText:       "오늘의 특가",
+		},
+		Requires: []Requirement{RequireCoupang},
+		Browser:  true,
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewCoupangCollector(env.CoupangID())
+		products, err := c.GetGoldboxProducts(ctx, 10+len(env.Exclude))
+		if err != nil {
+			return nil, fmt.Errorf("크롤링 실패: %w", err)
+		}
+		products = filterExcluded(env, products, CoupangProduct.SourceID)
+		if len(products) > 10 {
+			products = products[:10]
+		}
+		if len(products) == 0 {
+			return nil, fmt.Errorf("상품 없음, 건너뜀")
+		}
+		return c.GenerateCoupangPost(products), nil
+	}))
+}
+
 // Connect 브라우저 연결
 func (c *CoupangCollector) Connect() error {
 	l := launcher.New().
@@ -69,9 +101,12 @@ func (c *CoupangCollector) Connect() error {
 }
 
 // Close 브라우저 종료
-func (c *CoupangCollector) Close() {
+func (c *CoupangCollector) Close(ctx context.Context) {
 	if c.browser != nil {
-		c.browser.MustClose()
+		if err := c.browser.Close(); err != nil {
+			logging.From(ctx).Warn("⚠️ 쿠팡 브라우저 종료 실패", "err", err)
+		}
+		c.browser = nil
 	}
 }
 
@@ -80,15 +115,17 @@ func (c *CoupangCollector) GetGoldboxProducts(ctx context.Context, limit int) ([
 	if err := c.Connect(); err != nil {
 		return nil, err
 	}
-	defer c.Close()
+	defer c.Close(ctx)
 
-	fmt.Println("    🌐 쿠팡 골드박스 페이지 로딩 중...")
+	log := logging.From(ctx)
+	log.Debug("🌐 쿠팡 골드박스 페이지 로딩 중...")
 
 	page, err := c.browser.Page(proto.TargetCreateTarget{URL: "https://www.coupang.com/np/goldbox"})
 	if err != nil {
 		return nil, fmt.Errorf("페이지 열기 실패: %w", err)
 	}
 	defer page.Close()
+	page = page.Context(ctx)
 
 	// 페이지 로딩 대기
 	if err := page.WaitLoad(); err != nil {
@@ -96,19 +133,25 @@ func (c *CoupangCollector) GetGoldboxProducts(ctx context.Context, limit int) ([
 	}
 
 	// 추가 대기 (리다이렉트 + 동적 컨텐츠)
-	fmt.Println("    ⏳ 페이지 로딩 대기...")
-	time.Sleep(5 * time.Second)
+	log.Debug("⏳ 페이지 로딩 대기...")
+	if err := sleepContext(ctx, 5*time.Second); err != nil {
+		return nil, err
+	}
 
 	// 스크롤 다운해서 더 많은 상품 로딩
-	page.MustEval(`() => window.scrollTo(0, 1000)`)
-	time.Sleep(2 * time.Second)
-	page.MustEval(`() => window.scrollTo(0, 2000)`)
-	time.Sleep(1 * time.Second)
+	for _, y := range []int{1000, 2000} {
+		if _, err := page.Eval(`(y) => window.scrollTo(0, y)`, y); err != nil {
+			return nil, fmt.Errorf("페이지 스크롤 실패: %w", err)
+		}
+		if err := sleepContext(ctx, time.Second); err != nil {
+			return nil, err
+		}
+	}
 
-	fmt.Println("    📦 상품 정보 추출 중...")
+	log.Debug("📦 상품 정보 추출 중...")
 
 	// JavaScript로 상품 정보 추출 (링크 기반)
-	result := page.MustEval(`(limit) => {
+	result, err := page.Eval(`(limit) => {
 		const products = [];
 		
 		// 상품 링크로 찾기
@@ -165,10 +208,13 @@ func (c *CoupangCollector) GetGoldboxProducts(ctx context.Context, limit int) ([
 		
 		return products;
 	}`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("상품 정보 추출 실패: %w", err)
+	}
 
 	// 결과 파싱
 	var products []CoupangProduct
-	arr := result.Arr()
+	arr := result.Value.Arr()
 
 	for _, item := range arr {
 		m := item.Map()
@@ -189,7 +235,7 @@ func (c *CoupangCollector) GetGoldboxProducts(ctx context.Context, limit int) ([
 		}
 	}
 
-	fmt.Printf("    ✅ %d개 상품 수집 완료\n", len(products))
+	log.Info("✅ 쿠팡 상품 수집 완료", "products", len(products))
 	return products, nil
 }
 
@@ -255,6 +301,23 @@ func (c *CoupangCollector) extractProductID(url string) string {
 	return ""
 }
 
+// SourceID 중복 검사용 상품 식별자 (상품 ID, 없으면 URL)
+func (p CoupangProduct) SourceID() string {
+	if p.ProductID != "" {
+		return p.ProductID
+	}
+	return p.ProductURL
+}
+
+// productSourceIDs 상품 목록의 식별자 목록
+func productSourceIDs(products []CoupangProduct) []string {
+	ids := make([]string, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.SourceID())
+	}
+	return ids
+}
+
 // GeneratePartnerLink 파트너스 링크 생성
 func (c *CoupangCollector) GeneratePartnerLink(productURL string) string {
 	// 쿠팡 파트너스 딥링크 형식
@@ -272,128 +335,71 @@ func (c *CoupangCollector) GeneratePartnerLink(productURL string) string {
 	return fmt.Sprintf("%s%swPcid=%s&sfrn=AFFILIATE", productURL, separator, c.partnerID)
 }
 
-// GenerateCoupangPost 쿠팡 특가 포스트 생성
-func (c *CoupangCollector) GenerateCoupangPost(products []CoupangProduct) *Post {
-	now := time.Now()
-	title := fmt.Sprintf("[%s] 오늘의 쿠팡 특가 🛒 최대 %d%% 할인", now.Format("01/02"), c.getMaxDiscount(products))
+// CoupangView 쿠팡 특가 포스트 템플릿 데이터 (theme: coupang, coupang-category)
+type CoupangView struct {
+	Updated  string
+	Emoji    string // 카테고리 포스트 전용
+	Category string // 카테고리 포스트 전용
+	Products []CoupangProductView
+}
 
-	var content strings.Builder
-
-	// 스타일 정의
-	content.WriteString(`
-<style>
-.coupang-container { max-width: 800px; margin: 0 auto; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', sans-serif; }
-.coupang-header { background: linear-gradient(135deg, #00A0E4 0%, #0075C4 100%); color: white; padding: 30px; border-radius: 16px; text-align: center; margin-bottom: 30px; }
-.coupang-header h1 { margin: 0 0 10px 0; font-size: 28px; }
-.coupang-header p { margin: 0; opacity: 0.9; }
-.product-grid { display: grid; gap: 20px; }
-.product-card { background: #fff; border: 1px solid #e5e5e5; border-radius: 12px; overflow: hidden; transition: all 0.3s; }
-.product-card:hover { box-shadow: 0 8px 25px rgba(0,0,0,0.1); transform: translateY(-2px); }
-.product-image { width: 100%; height: 200px; object-fit: cover; background: #f5f5f5; }
-.product-info { padding: 16px; }
-.product-title { font-size: 15px; font-weight: 600; color: #111; line-height: 1.4; margin-bottom: 12px; display: -webkit-box; -webkit-line-clamp: 2; -webkit-box-orient: vertical; overflow: hidden; }
-.price-section { display: flex; align-items: center; gap: 8px; margin-bottom: 8px; }
-.discount-badge { background: #f03e3e; color: white; padding: 4px 8px; border-radius: 4px; font-weight: 700; font-size: 14px; }
-.current-price { font-size: 22px; font-weight: 700; color: #111; }
-.original-price { font-size: 14px; color: #999; text-decoration: line-through; }
-.badges { display: flex; gap: 6px; margin-bottom: 12px; }
-.badge { font-size: 11px; padding: 3px 8px; border-radius: 4px; }
-.badge-rocket { background: #0073e9; color: white; }
-.badge-best { background: #ff6b35; color: white; }
-.buy-button { display: block; width: 100%; background: #00a0e4; color: white; text-align: center; padding: 14px; text-decoration: none; font-weight: 600; border-radius: 8px; transition: background 0.2s; }
-.buy-button:hover { background: #0085c4; color: white; }
-.footer-notice { background: #f9f9f9; padding: 20px; border-radius: 12px; margin-top: 30px; font-size: 13px; color: #666; }
-.footer-notice p { margin: 5px 0; }
-</style>
-`)
-
-	content.WriteString(`<div class="coupang-container">`)
-
-	// 헤더
-	content.WriteString(fmt.Sprintf(`
-<div class="coupang-header">
-	<h1>🛒 오늘의 쿠팡 특가</h1>
-	<p>%s 업데이트 | 놓치면 후회할 핫딜 모음!</p>
-</div>
-`, now.Format("2006년 01월 02일 15:04")))
-
-	// 상품 그리드
-	content.WriteString(`<div class="product-grid">`)
+// CoupangProductView 상품 카드
+type CoupangProductView struct {
+	CoupangProduct
+	Rank      int
+	Link      string // 파트너스 링크
+	Image     string // 이미지 없으면 placeholder
+	PriceText string
+	OrigText  string // 정가가 판매가와 다를 때만
+}
 
+// productViews 상품 → 카드 데이터
+func (c *CoupangCollector) productViews(products []CoupangProduct) []CoupangProductView {
+	views := make([]CoupangProductView, 0, len(products))
 	for i, product := range products {
-		partnerLink := c.GeneratePartnerLink(product.ProductURL)
-
-		// 이미지 URL 처리
-		imageURL := product.ImageURL
-		if imageURL == "" {
-			imageURL = "https://via.placeholder.com/300x200?text=No+Image"
+		view := CoupangProductView{
+			CoupangProduct: product,
+			Rank:           i + 1,
+			Link:           c.GeneratePartnerLink(product.ProductURL),
+			Image:          product.ImageURL,
 		}
-
-		content.WriteString(fmt.Sprintf(`
-<div class="product-card">
-	<a href="%s" target="_blank" rel="noopener">
-		<img src="%s" alt="%s" class="product-image" loading="lazy" onerror="this.src='https://via.placeholder.com/300x200?text=Image'">
-	</a>
-	<div class="product-info">
-		<div class="product-title">%d. %s</div>
-		<div class="badges">
-`, partnerLink, imageURL, product.Title, i+1, product.Title))
-
-		// 뱃지
-		if product.IsRocket {
-			content.WriteString(`<span class="badge badge-rocket">🚀 로켓배송</span>`)
-		}
-		if product.DiscountRate >= 50 {
-			content.WriteString(`<span class="badge badge-best">🔥 초특가</span>`)
-		}
-
-		content.WriteString(`</div>`)
-
-		// 가격 정보
-		content.WriteString(`<div class="price-section">`)
-		if product.DiscountRate > 0 {
-			content.WriteString(fmt.Sprintf(`<span class="discount-badge">%d%%</span>`, product.DiscountRate))
+		if view.Image == "" {
+			view.Image = "https://via.placeholder.com/300x200?text=No+Image"
 		}
 		if product.Price > 0 {
-			content.WriteString(fmt.Sprintf(`<span class="current-price">%s원</span>`, c.formatPrice(product.Price)))
+			view.PriceText = c.formatPrice(product.Price)
 		}
-		content.WriteString(`</div>`)
-
 		if product.OrigPrice > 0 && product.OrigPrice != product.Price {
-			content.WriteString(fmt.Sprintf(`<div class="original-price">정가 %s원</div>`, c.formatPrice(product.OrigPrice)))
+			view.OrigText = c.formatPrice(product.OrigPrice)
 		}
-
-		content.WriteString(fmt.Sprintf(`
-		<a href="%s" target="_blank" rel="noopener" class="buy-button">👉 최저가 구매하기</a>
-	</div>
-</div>
-`, partnerLink))
+		views = append(views, view)
 	}
+	return views
+}
 
-	content.WriteString(`</div>`) // product-grid 끝
-
-	// 푸터
-	content.WriteString(`
-<div class="footer-notice">
-	<p>💡 <strong>Tip:</strong> 쿠팡은 가격이 수시로 변동됩니다. 마음에 드는 상품은 빨리 구매하세요!</p>
-	<p>📦 로켓배송 상품은 오늘 주문하면 내일 도착!</p>
-	<p>⚠️ 본 포스팅은 쿠팡 파트너스 활동의 일환으로, 이에 따른 일정액의 수수료를 제공받습니다.</p>
-</div>
-`)
+// GenerateCoupangPost 쿠팡 특가 포스트 생성
+func (c *CoupangCollector) GenerateCoupangPost(products []CoupangProduct) *Post {
+	now := clock()
+	title := fmt.Sprintf("[%s] 오늘의 쿠팡 특가 🛒 최대 %d%% 할인", now.Format("01/02"), c.getMaxDiscount(products))
 
-	content.WriteString(`</div>`) // container 끝
+	view := CoupangView{
+		Updated:  now.Format("2006년 01월 02일 15:04"),
+		Products: c.productViews(products),
+	}
 
 	return &Post{
-		Title:    title,
-		Content:  content.String(),
-		Category: CategoryCoupang,
-		Tags:     []string{"쿠팡", "쿠팡특가", "골드박스", "핫딜", "오늘의특가", "로켓배송", "최저가"},
+		Title:     title,
+		Template:  "coupang",
+		Data:      view,
+		Category:  CategoryCoupang,
+		Tags:      []string{"쿠팡", "쿠팡특가", "골드박스", "핫딜", "오늘의특가", "로켓배송", "최저가"},
+		SourceIDs: productSourceIDs(products),
 	}
 }
 
 // GenerateCategoryPost 카테고리별 포스트 생성
 func (c *CoupangCollector) GenerateCategoryPost(products []CoupangProduct, categoryName string) *Post {
-	now := time.Now()
+	now := clock()
 
 	// 카테고리별 이모지
 	emoji := "🛒"
@@ -412,74 +418,20 @@ func (c *CoupangCollector) GenerateCategoryPost(products []CoupangProduct, categ
 
 	title := fmt.Sprintf("[%s] %s %s 베스트 특가 TOP %d", now.Format("01/02"), emoji, categoryName, len(products))
 
-	var content strings.Builder
-
-	content.WriteString(fmt.Sprintf(`
-<h2>%s %s 카테고리 인기 특가</h2>
-<p>📅 %s 기준 | 실시간 베스트 상품</p>
-<hr>
-`, emoji, categoryName, now.Format("2006년 01월 02일 15:04")))
-
-	for i, product := range products {
-		partnerLink := c.GeneratePartnerLink(product.ProductURL)
-
-		content.WriteString(fmt.Sprintf(`
-<div style="border: 2px solid #00a0e4; border-radius: 12px; padding: 20px; margin: 15px 0; background: #fafafa;">
-	<h3 style="margin: 0 0 10px 0; color: #333;">%d위. %s</h3>
-`, i+1, product.Title))
-
-		if product.ImageURL != "" {
-			content.WriteString(fmt.Sprintf(`
-	<div style="text-align: center; margin: 15px 0;">
-		<img src="%s" alt="%s" style="max-width: 100%%; height: auto; border-radius: 8px;">
-	</div>
-`, product.ImageURL, product.Title))
-		}
-
-		// 가격 정보
-		content.WriteString(`<div style="background: #fff; padding: 15px; border-radius: 8px; margin: 10px 0;">`)
-
-		if product.DiscountRate > 0 {
-			content.WriteString(fmt.Sprintf(`<span style="background: #f03e3e; color: white; padding: 5px 10px; border-radius: 5px; font-weight: bold; margin-right: 10px;">%d%% 할인</span>`, product.DiscountRate))
-		}
-
-		if product.Price > 0 {
-			content.WriteString(fmt.Sprintf(`<span style="font-size: 24px; font-weight: bold; color: #111;">%s원</span>`, c.formatPrice(product.Price)))
-		}
-
-		if product.OrigPrice > 0 && product.OrigPrice != product.Price {
-			content.WriteString(fmt.Sprintf(`<br><span style="text-decoration: line-through; color: #999;">정가 %s원</span>`, c.formatPrice(product.OrigPrice)))
-		}
-
-		content.WriteString(`</div>`)
-
-		// 뱃지들
-		if product.IsRocket {
-			content.WriteString(`<span style="background: #0073e9; color: white; padding: 3px 8px; border-radius: 4px; font-size: 12px; margin-right: 5px;">🚀 로켓배송</span>`)
-		}
-
-		// 구매 버튼
-		content.WriteString(fmt.Sprintf(`
-	<div style="margin-top: 15px;">
-		<a href="%s" target="_blank" style="display: inline-block; background: #00a0e4; color: white; padding: 12px 30px; border-radius: 8px; text-decoration: none; font-weight: bold;">👉 최저가 확인하기</a>
-	</div>
-</div>
-`, partnerLink))
+	view := CoupangView{
+		Updated:  now.Format("2006년 01월 02일 15:04"),
+		Emoji:    emoji,
+		Category: categoryName,
+		Products: c.productViews(products),
 	}
 
-	content.WriteString(`
-<hr>
-<p style="background: #f5f5f5; padding: 15px; border-radius: 8px; font-size: 13px; color: #666;">
-⚠️ 본 포스팅은 쿠팡 파트너스 활동의 일환으로, 이에 따른 일정액의 수수료를 제공받습니다.<br>
-💡 가격 및 재고는 수시로 변동될 수 있으니 구매 전 확인해주세요.
-</p>
-`)
-
 	return &Post{
-		Title:    title,
-		Content:  content.String(),
-		Category: CategoryCoupang,
-		Tags:     []string{"쿠팡", categoryName, "특가", "베스트", "추천", "할인"},
+		Title:     title,
+		Template:  "coupang-category",
+		Data:      view,
+		Category:  CategoryCoupang,
+		Tags:      []string{"쿠팡", categoryName, "특가", "베스트", "추천", "할인"},
+		SourceIDs: productSourceIDs(products),
 	}
 }
 
@@ -645,3 +597,15 @@ func (c *CoupangCollector) GetMockProducts(limit int) []CoupangProduct {
 
 	return mockProducts[:limit]
 }
+
+// sleepContext d만큼 대기 (ctx가 취소되면 즉시 반환)
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
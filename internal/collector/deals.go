@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"golang.org/x/net/html"
@@ -12,7 +11,7 @@ import (
 
 // DealsCollector 핫딜 정보 수집기
 type DealsCollector struct {
-	client *http.Client
+	client HTTPDoer
 }
 
 // Deal 할인 정보
@@ -30,7 +29,7 @@ type Deal struct {
 
 func NewDealsCollector() *DealsCollector {
 	return &DealsCollector{
-		client: &http.Client{Timeout: 30 * time.Second},
+		client: defaultHTTPClient(),
 	}
 }
 
@@ -75,34 +74,24 @@ func (d *DealsCollector) extractDeals(n *html.Node, deals *[]Deal, limit int) {
 	}
 }
 
+// DealsView 핫딜 포스트 템플릿 데이터 (theme: deals)
+type DealsView struct {
+	Updated string
+	Deals   []Deal
+}
+
 // GenerateDealsPost 핫딜 정보 포스트 생성
 func (d *DealsCollector) GenerateDealsPost(deals []Deal) *Post {
-	now := time.Now()
+	now := clock()
 	title := fmt.Sprintf("[%s] 오늘의 핫딜 모음 🔥", now.Format("01/02"))
 
-	var content strings.Builder
-	content.WriteString(`<h2>🛒 오늘의 핫딜 모음</h2>
-<p>업데이트: ` + now.Format("2006년 01월 02일 15:04") + `</p>
-`)
-
-	for i, deal := range deals {
-		content.WriteString(fmt.Sprintf(`
-<div style="border: 1px solid #ddd; padding: 15px; margin: 10px 0; border-radius: 8px;">
-<h3>%d. %s</h3>
-<p><strong style="color: red; font-size: 1.2em;">%s</strong> <del>%s</del></p>
-<p>할인율: %s | 출처: %s</p>
-<p><a href="%s" target="_blank">👉 바로가기</a></p>
-</div>
-`, i+1, deal.Title, deal.Price, deal.OrigPrice, deal.Discount, deal.Source, deal.URL))
-	}
-
-	content.WriteString(`
-<p><em>※ 가격 및 할인율은 변동될 수 있습니다. 구매 전 확인해주세요.</em></p>
-`)
-
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
+		Template: "deals",
+		Data: DealsView{
+			Updated: now.Format("2006년 01월 02일 15:04"),
+			Deals:   deals,
+		},
 		Category: CategoryDeal,
 		Tags:     []string{"핫딜", "특가", "할인", "쿠팡", "최저가"},
 	}
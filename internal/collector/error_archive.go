@@ -4,16 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
+	"image/color"
 	"math/rand"
 	"net/http"
 	"regexp"
 	"strings"
-	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // ErrorArchiveCollector 에러/장애 해결 아카이브 수집기
 type ErrorArchiveCollector struct {
-	client *http.Client
+	client HTTPDoer
 }
 
 // ErrorEntry 에러 정보
@@ -34,10 +37,28 @@ type ErrorEntry struct {
 // NewErrorArchiveCollector 생성자
 func NewErrorArchiveCollector() *ErrorArchiveCollector {
 	return &ErrorArchiveCollector{
-		client: &http.Client{Timeout: 30 * time.Second},
+		client: defaultHTTPClient(),
 	}
 }
 
+func init() {
+	Register(NewSource("error", SourceMeta{
+		Description:  "에러/장애 해결 아카이브 🔴",
+		PostCategory: CategoryError,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{45, 52, 54, 255},   // 다크그레이
+			GradientEnd:   color.RGBA{99, 110, 114, 255}, // 그레이
+			Emoji:         "DEBUG",
+			SubText:       "에러 해결",
+		},
+		SkipInRun: true,
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewErrorArchiveCollector()
+		c.client = env.Doer()
+		return c.GenerateErrorPost(ctx), nil
+	}))
+}
+
 // GetStackOverflowErrors Stack Overflow에서 인기 에러 수집
 func (c *ErrorArchiveCollector) GetStackOverflowErrors(ctx context.Context, tag string, limit int) ([]ErrorEntry, error) {
 	// Stack Overflow API - 인기 질문
@@ -514,8 +535,8 @@ app.listen(PORT, () => console.log('Server running on port ' + PORT));`,
 	}
 
 	// 셔플
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(filtered), func(i, j int) {
+	rng := rand.New(rand.NewSource(clock().UnixNano()))
+	rng.Shuffle(len(filtered), func(i, j int) {
 		filtered[i], filtered[j] = filtered[j], filtered[i]
 	})
 
@@ -532,9 +553,24 @@ func (c *ErrorArchiveCollector) getSimulatedGitHubErrors(language string, limit
 	return c.getSimulatedSOErrors(language, limit)
 }
 
+// ErrorView 에러 해결 포스트 템플릿 데이터 (theme: error)
+type ErrorView struct {
+	Main    ErrorEntry
+	Code    string // 강조 처리된 코드 예시 (HTML)
+	Related []ErrorRelatedView
+	Date    string
+}
+
+// ErrorRelatedView 관련 에러 항목
+type ErrorRelatedView struct {
+	Title    string
+	Language string
+	Views    string
+}
+
 // GenerateErrorPost 에러 해결 포스트 생성
 func (c *ErrorArchiveCollector) GenerateErrorPost(ctx context.Context) *Post {
-	now := time.Now()
+	now := clock()
 
 	// 언어 목록 (순환)
 	languages := []string{"javascript", "python", "go", "typescript", "react"}
@@ -553,111 +589,14 @@ func (c *ErrorArchiveCollector) GenerateErrorPost(ctx context.Context) *Post {
 	title := fmt.Sprintf("[%s] %s - 원인과 해결방법 완벽 정리",
 		mainError.Language, mainError.ErrorMsg)
 
-	// 본문 생성
-	var content strings.Builder
-
-	// 스타일
-	content.WriteString(`
-<style>
-.error-container { max-width: 900px; margin: 0 auto; font-family: 'Fira Code', 'Consolas', monospace; }
-.error-header { background: linear-gradient(135deg, #1a1a2e 0%, #16213e 100%); color: #fff; padding: 40px; border-radius: 16px; margin-bottom: 30px; }
-.error-header h1 { margin: 0 0 15px 0; font-size: 24px; color: #e94560; }
-.error-header .lang-badge { display: inline-block; background: #e94560; padding: 4px 12px; border-radius: 4px; font-size: 12px; margin-bottom: 15px; }
-.error-msg { background: #0f0f23; padding: 20px; border-radius: 8px; font-family: monospace; color: #ff6b6b; font-size: 14px; overflow-x: auto; }
-.section { background: #fff; border: 1px solid #e5e5e5; border-radius: 12px; padding: 25px; margin-bottom: 20px; }
-.section h2 { margin: 0 0 15px 0; color: #1a1a2e; font-size: 18px; display: flex; align-items: center; gap: 10px; }
-.section h2::before { content: ''; width: 4px; height: 20px; background: #e94560; border-radius: 2px; }
-.cause-box { background: #fff3cd; border-left: 4px solid #ffc107; padding: 15px 20px; border-radius: 0 8px 8px 0; }
-.solution-box { background: #d4edda; border-left: 4px solid #28a745; padding: 15px 20px; border-radius: 0 8px 8px 0; }
-.code-block { background: #1e1e1e; color: #d4d4d4; padding: 20px; border-radius: 8px; overflow-x: auto; font-size: 13px; line-height: 1.6; }
-.code-block .comment { color: #6a9955; }
-.code-block .error { color: #f14c4c; }
-.code-block .success { color: #4ec9b0; }
-.more-errors { background: #f8f9fa; padding: 25px; border-radius: 12px; margin-top: 30px; }
-.more-errors h3 { margin: 0 0 15px 0; }
-.error-item { padding: 15px; background: #fff; border-radius: 8px; margin-bottom: 10px; border-left: 3px solid #e94560; }
-.error-item .title { font-weight: 600; color: #333; margin-bottom: 5px; }
-.error-item .meta { font-size: 12px; color: #666; }
-.tags { display: flex; gap: 8px; flex-wrap: wrap; margin-top: 15px; }
-.tag { font-size: 11px; padding: 3px 10px; background: #e9ecef; color: #495057; border-radius: 4px; }
-.footer-note { margin-top: 30px; padding: 20px; background: #f5f5f5; border-radius: 12px; font-size: 13px; color: #666; }
-.source-link { color: #e94560; text-decoration: none; }
-</style>
-`)
-
-	content.WriteString(`<div class="error-container">`)
-
-	// 헤더
-	content.WriteString(fmt.Sprintf(`
-<div class="error-header">
-	<span class="lang-badge">%s</span>
-	<h1>🔴 %s</h1>
-	<div class="error-msg">%s</div>
-</div>
-`, mainError.Language, mainError.Title, mainError.ErrorMsg))
-
-	// 원인 섹션
-	content.WriteString(fmt.Sprintf(`
-<div class="section">
-	<h2>❓ 왜 이 에러가 발생하나요?</h2>
-	<div class="cause-box">
-		<p>%s</p>
-	</div>
-</div>
-`, mainError.Cause))
-
-	// 해결책 섹션
-	content.WriteString(fmt.Sprintf(`
-<div class="section">
-	<h2>✅ 해결 방법</h2>
-	<div class="solution-box">
-		<p>%s</p>
-	</div>
-</div>
-`, mainError.Solution))
-
-	// 코드 예시
-	content.WriteString(fmt.Sprintf(`
-<div class="section">
-	<h2>💻 코드 예시</h2>
-	<pre class="code-block">%s</pre>
-</div>
-`, c.formatCodeBlock(mainError.CodeExample)))
-
-	// 관련 에러 더보기
-	if len(errors) > 1 {
-		content.WriteString(`
-<div class="more-errors">
-	<h3>📚 관련 에러 더보기</h3>
-`)
-		for _, e := range errors[1:] {
-			content.WriteString(fmt.Sprintf(`
-	<div class="error-item">
-		<div class="title">%s</div>
-		<div class="meta">🏷️ %s | 👀 조회수 %s</div>
-	</div>
-`, e.Title, e.Language, formatViews(e.Views)))
-		}
-		content.WriteString(`</div>`)
+	view := ErrorView{
+		Main: mainError,
+		Code: c.formatCodeBlock(mainError.CodeExample),
+		Date: now.Format("2006년 01월 02일"),
 	}
-
-	// 태그
-	content.WriteString(`<div class="tags">`)
-	for _, tag := range mainError.Tags {
-		content.WriteString(fmt.Sprintf(`<span class="tag">#%s</span>`, tag))
+	for _, e := range errors[1:] {
+		view.Related = append(view.Related, ErrorRelatedView{Title: e.Title, Language: e.Language, Views: formatViews(e.Views)})
 	}
-	content.WriteString(`</div>`)
-
-	// 푸터
-	content.WriteString(fmt.Sprintf(`
-<div class="footer-note">
-	<p>📅 작성일: %s</p>
-	<p>💡 이 글이 도움이 되셨다면 공유해주세요!</p>
-	<p>🔍 더 많은 에러 해결법은 블로그를 구독해주세요.</p>
-</div>
-`, now.Format("2006년 01월 02일")))
-
-	content.WriteString(`</div>`)
 
 	// 공격적인 태그 전략
 	langLower := strings.ToLower(mainError.Language)
@@ -679,8 +618,9 @@ func (c *ErrorArchiveCollector) GenerateErrorPost(ctx context.Context) *Post {
 
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: "에러/해결",
+		Template: "error",
+		Data:     view,
+		Category: CategoryError,
 		Tags:     tags,
 	}
 }
@@ -695,7 +635,8 @@ func (c *ErrorArchiveCollector) truncateTitle(s string, maxLen int) string {
 
 // formatCodeBlock 코드 블록 포맷팅
 func (c *ErrorArchiveCollector) formatCodeBlock(code string) string {
-	// 주석 강조
+	// 코드 안의 태그 문자 이스케이프 후 주석 강조
+	code = html.EscapeString(code)
 	code = strings.ReplaceAll(code, "// ❌", `<span class="error">// ❌</span>`)
 	code = strings.ReplaceAll(code, "// ✅", `<span class="success">// ✅</span>`)
 	code = strings.ReplaceAll(code, "// ⚠️", `<span class="comment">// ⚠️</span>`)
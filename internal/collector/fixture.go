@@ -0,0 +1,205 @@
+package collector
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrFixtureNotFound 재생할 녹화 응답이 없음
+var ErrFixtureNotFound = errors.New("녹화된 응답 없음")
+
+// Fixture 녹화된 HTTP 응답 1건
+type Fixture struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Status     int         `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+	RecordedAt time.Time   `json:"recorded_at"`
+}
+
+// secretParams 녹화 파일에 남기지 않을 쿼리 파라미터 (API 키 등)
+var secretParams = []string{"api_key", "apikey", "key", "serviceKey", "token", "access_token", "client_secret"}
+
+// redactURL 쿼리의 비밀 값을 REDACTED로 치환한 URL 문자열
+func redactURL(u *url.URL) string {
+	q := u.Query()
+	changed := false
+	for _, name := range secretParams {
+		if q.Has(name) {
+			q.Set(name, "REDACTED")
+			changed = true
+		}
+	}
+	if !changed {
+		return u.String()
+	}
+	out := *u
+	out.RawQuery = q.Encode()
+	return out.String()
+}
+
+// fixtureKey 요청 → 파일 경로 ({dir}/{host}/{sha1(method url)}.json, 비밀 값 제외)
+func fixtureKey(dir, method string, u *url.URL) string {
+	sum := sha1.Sum([]byte(method + " " + redactURL(u)))
+	return filepath.Join(dir, fixtureHost(u), hex.EncodeToString(sum[:8])+".json")
+}
+
+// fixtureHost 파일 경로에 쓸 호스트 이름 (포트 구분자 제거)
+func fixtureHost(u *url.URL) string {
+	return strings.ReplaceAll(u.Host, ":", "_")
+}
+
+// RecordDoer 실제 응답을 Next로 받아 Dir에 저장하는 HTTPDoer
+type RecordDoer struct {
+	Dir  string
+	Next HTTPDoer
+
+	mu    sync.Mutex
+	count int
+}
+
+// Do HTTPDoer 구현
+func (d *RecordDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.Next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("응답 읽기 실패: %w", err)
+	}
+
+	fx := Fixture{
+		Method:     req.Method,
+		URL:        redactURL(req.URL),
+		Status:     resp.StatusCode,
+		Header:     http.Header{"Content-Type": resp.Header.Values("Content-Type")},
+		Body:       string(body),
+		RecordedAt: clock(),
+	}
+	if err := d.save(fixtureKey(d.Dir, req.Method, req.URL), fx); err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// Count 저장한 응답 수
+func (d *RecordDoer) Count() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.count
+}
+
+// save 녹화 파일 저장
+func (d *RecordDoer) save(path string, fx Fixture) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("녹화 디렉토리 생성 실패: %w", err)
+	}
+	data, err := json.MarshalIndent(fx, "", "  ")
+	if err != nil {
+		return fmt.Errorf("녹화 직렬화 실패: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("녹화 저장 실패: %w", err)
+	}
+	d.count++
+	return nil
+}
+
+// ReplayDoer Dir에 녹화된 응답을 돌려주는 HTTPDoer (네트워크 사용 안 함)
+// 같은 요청이 없으면 쿼리를 무시하고 method/host/path가 같은 녹화를 사용한다.
+type ReplayDoer struct {
+	Dir string
+}
+
+// Do HTTPDoer 구현
+func (d *ReplayDoer) Do(req *http.Request) (*http.Response, error) {
+	fx, err := d.lookup(req)
+	if err != nil {
+		return nil, err
+	}
+
+	header := fx.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fx.Status, http.StatusText(fx.Status)),
+		StatusCode:    fx.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(fx.Body)),
+		ContentLength: int64(len(fx.Body)),
+		Request:       req,
+	}, nil
+}
+
+// lookup 요청에 맞는 녹화 검색
+func (d *ReplayDoer) lookup(req *http.Request) (*Fixture, error) {
+	if fx, err := readFixture(fixtureKey(d.Dir, req.Method, req.URL)); err == nil {
+		return fx, nil
+	}
+
+	files, _ := filepath.Glob(filepath.Join(d.Dir, fixtureHost(req.URL), "*.json"))
+	for _, file := range files {
+		fx, err := readFixture(file)
+		if err != nil {
+			continue
+		}
+		u, err := url.Parse(fx.URL)
+		if err != nil {
+			continue
+		}
+		if fx.Method == req.Method && u.Host == req.URL.Host && u.Path == req.URL.Path {
+			return fx, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrFixtureNotFound, req.Method, req.URL)
+}
+
+// readFixture 녹화 파일 읽기
+func readFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var fx Fixture
+	if err := json.Unmarshal(data, &fx); err != nil {
+		return nil, fmt.Errorf("녹화 파싱 실패 (%s): %w", path, err)
+	}
+	return &fx, nil
+}
+
+// RenderGolden 골든 파일 비교용 포스트 출력 (제목/카테고리/태그/본문)
+func RenderGolden(post *Post) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!-- title: %s -->\n", post.Title)
+	fmt.Fprintf(&b, "<!-- category: %s -->\n", post.Category)
+	fmt.Fprintf(&b, "<!-- tags: %s -->\n", strings.Join(post.Tags, ", "))
+	b.WriteString(post.Content)
+	if !strings.HasSuffix(post.Content, "\n") {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -1,10 +1,13 @@
 package collector
 
 import (
+	"context"
 	"fmt"
+	"image/color"
 	"math/rand"
 	"strings"
-	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // FortuneCollector 운세 정보 수집기
@@ -40,6 +43,23 @@ func NewFortuneCollector(coupangID string) *FortuneCollector {
 	return &FortuneCollector{coupangID: coupangID}
 }
 
+func init() {
+	Register(NewSource("fortune", SourceMeta{
+		Description:  "오늘의 운세",
+		PostCategory: CategoryFortune,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{255, 215, 0, 255}, // 골드
+			GradientEnd:   color.RGBA{255, 140, 0, 255}, // 다크오렌지
+			Emoji:         "FORTUNE",
+			SubText:       "오늘의 운세",
+		},
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewFortuneCollector(env.CoupangID())
+		fortunes := c.GetTodayFortune()
+		return c.GenerateFortunePost(fortunes), nil
+	}))
+}
+
 // 띠 목록
 var zodiacs = []struct {
 	Name      string
@@ -152,7 +172,7 @@ var advicePool = []string{
 
 // GetTodayFortune 오늘의 띠별 운세 생성
 func (f *FortuneCollector) GetTodayFortune() []ZodiacFortune {
-	now := time.Now()
+	now := clock()
 
 	// 날짜 + 시간 기반 시드 (시간대별로 다른 결과)
 	baseSeed := now.Year()*10000 + int(now.Month())*100 + now.Day()
@@ -231,53 +251,32 @@ func (f *FortuneCollector) generateScore(rng *rand.Rand, offset int) int {
 	return result
 }
 
+// FortuneView 띠별 운세 포스트 템플릿 데이터 (theme: fortune)
+type FortuneView struct {
+	Date  string
+	Cards []FortuneCard
+}
+
+// FortuneCard 띠별 운세 카드
+type FortuneCard struct {
+	ZodiacFortune
+	Element string       // 띠 특성
+	Grade   string       // 종합운 문구
+	Stars   FortuneStars // 항목별 별점 (★☆)
+	ShopURL string       // 행운 아이템 쿠팡 검색 링크
+}
+
+// FortuneStars 항목별 별점 문자열
+type FortuneStars struct {
+	Overall, Love, Money, Health, Work string
+}
+
 // GenerateFortunePost 운세 포스트 생성
 func (f *FortuneCollector) GenerateFortunePost(fortunes []ZodiacFortune) *Post {
-	now := time.Now()
+	now := clock()
 	title := fmt.Sprintf("🔮 [%s] 오늘의 띠별 운세 & 행운 아이템 추천", now.Format("01/02"))
 
-	var content strings.Builder
-
-	// 스타일
-	content.WriteString(`
-<style>
-.fortune-container { max-width: 900px; margin: 0 auto; font-family: -apple-system, BlinkMacSystemFont, sans-serif; }
-.fortune-header { background: linear-gradient(135deg, #667eea 0%, #764ba2 100%); padding: 30px; border-radius: 20px; color: white; text-align: center; margin-bottom: 25px; }
-.fortune-header h1 { margin: 0; font-size: 28px; }
-.fortune-header p { margin: 10px 0 0 0; opacity: 0.9; }
-.zodiac-card { background: #fff; border-radius: 16px; padding: 25px; margin-bottom: 20px; box-shadow: 0 4px 15px rgba(0,0,0,0.08); border-left: 5px solid #667eea; }
-.zodiac-header { display: flex; align-items: center; gap: 15px; margin-bottom: 15px; }
-.zodiac-emoji { font-size: 48px; }
-.zodiac-name { font-size: 24px; font-weight: 700; color: #2d3436; }
-.zodiac-element { font-size: 14px; color: #636e72; }
-.score-grid { display: grid; grid-template-columns: repeat(5, 1fr); gap: 10px; margin: 20px 0; }
-.score-item { text-align: center; padding: 15px 10px; background: #f8f9fa; border-radius: 10px; }
-.score-label { font-size: 12px; color: #636e72; margin-bottom: 5px; }
-.score-stars { font-size: 14px; color: #f1c40f; }
-.lucky-section { display: grid; grid-template-columns: repeat(3, 1fr); gap: 15px; margin: 20px 0; }
-.lucky-item { background: linear-gradient(135deg, #fff9e6 0%, #fff3cd 100%); padding: 15px; border-radius: 12px; text-align: center; }
-.lucky-label { font-size: 12px; color: #856404; margin-bottom: 5px; }
-.lucky-value { font-size: 16px; font-weight: 600; color: #533f03; }
-.message-box { background: #e8f4fd; padding: 20px; border-radius: 12px; margin: 15px 0; }
-.message-text { font-size: 16px; color: #1565c0; margin: 0; line-height: 1.6; }
-.advice-box { background: #f0fff4; padding: 15px; border-radius: 10px; border-left: 4px solid #38a169; }
-.advice-text { font-size: 14px; color: #276749; margin: 0; }
-.product-recommend { background: linear-gradient(135deg, #fff5f5 0%, #ffe3e3 100%); padding: 20px; border-radius: 12px; margin-top: 15px; }
-.product-title { font-size: 14px; color: #c53030; margin: 0 0 10px 0; }
-.product-link { display: inline-block; background: #e53e3e; color: white; padding: 10px 20px; border-radius: 8px; text-decoration: none; font-weight: 600; }
-.product-link:hover { background: #c53030; }
-.footer-notice { margin-top: 30px; padding: 20px; background: #f8f9fa; border-radius: 12px; text-align: center; }
-</style>
-`)
-
-	content.WriteString(fmt.Sprintf(`
-<div class="fortune-container">
-<div class="fortune-header">
-	<h1>🔮 오늘의 띠별 운세</h1>
-	<p>%s | 행운의 아이템과 함께하는 특별한 하루</p>
-</div>
-`, now.Format("2006년 01월 02일 (Mon)")))
-
+	view := FortuneView{Date: now.Format("2006년 01월 02일 (Mon)")}
 	for _, fortune := range fortunes {
 		// 총점 계산
 		avgScore := (fortune.Overall + fortune.Love + fortune.Money + fortune.Health + fortune.Work) / 5
@@ -291,95 +290,21 @@ func (f *FortuneCollector) GenerateFortunePost(fortunes []ZodiacFortune) *Post {
 			}
 		}
 
-		content.WriteString(fmt.Sprintf(`
-<div class="zodiac-card">
-	<div class="zodiac-header">
-		<span class="zodiac-emoji">%s</span>
-		<div>
-			<div class="zodiac-name">%s</div>
-			<div class="zodiac-element">%s | 오늘의 종합운 %s</div>
-		</div>
-	</div>
-
-	<div class="score-grid">
-		<div class="score-item">
-			<div class="score-label">종합운</div>
-			<div class="score-stars">%s</div>
-		</div>
-		<div class="score-item">
-			<div class="score-label">💕 애정</div>
-			<div class="score-stars">%s</div>
-		</div>
-		<div class="score-item">
-			<div class="score-label">💰 금전</div>
-			<div class="score-stars">%s</div>
-		</div>
-		<div class="score-item">
-			<div class="score-label">💪 건강</div>
-			<div class="score-stars">%s</div>
-		</div>
-		<div class="score-item">
-			<div class="score-label">💼 직장</div>
-			<div class="score-stars">%s</div>
-		</div>
-	</div>
-
-	<div class="lucky-section">
-		<div class="lucky-item">
-			<div class="lucky-label">🍀 행운의 아이템</div>
-			<div class="lucky-value">%s %s</div>
-		</div>
-		<div class="lucky-item">
-			<div class="lucky-label">🎨 행운의 색상</div>
-			<div class="lucky-value">%s</div>
-		</div>
-		<div class="lucky-item">
-			<div class="lucky-label">🔢 행운의 숫자</div>
-			<div class="lucky-value">%d</div>
-		</div>
-	</div>
-
-	<div class="message-box">
-		<p class="message-text">💬 %s</p>
-	</div>
-
-	<div class="advice-box">
-		<p class="advice-text">💡 오늘의 조언: %s</p>
-	</div>
-
-	<div class="product-recommend">
-		<p class="product-title">%s 오늘의 행운 아이템 쇼핑하기</p>
-		<a href="%s" target="_blank" class="product-link">🛒 %s 보러가기</a>
-	</div>
-</div>
-`, fortune.Emoji, fortune.Zodiac, element, getGradeText(avgScore),
-			getStarRating(fortune.Overall),
-			getStarRating(fortune.Love),
-			getStarRating(fortune.Money),
-			getStarRating(fortune.Health),
-			getStarRating(fortune.Work),
-			fortune.LuckyItem.Emoji, fortune.LuckyItem.Name,
-			fortune.LuckyColor,
-			fortune.LuckyNumber,
-			fortune.Message,
-			fortune.Advice,
-			fortune.Emoji,
-			f.generateCoupangSearchLink(fortune.LuckyItem.SearchQuery),
-			fortune.LuckyItem.Name,
-		))
+		view.Cards = append(view.Cards, FortuneCard{
+			ZodiacFortune: fortune,
+			Element:       element,
+			Grade:         getGradeText(avgScore),
+			Stars: FortuneStars{
+				Overall: getStarRating(fortune.Overall),
+				Love:    getStarRating(fortune.Love),
+				Money:   getStarRating(fortune.Money),
+				Health:  getStarRating(fortune.Health),
+				Work:    getStarRating(fortune.Work),
+			},
+			ShopURL: f.generateCoupangSearchLink(fortune.LuckyItem.SearchQuery),
+		})
 	}
 
-	content.WriteString(`
-<div class="footer-notice">
-	<p>🔮 운세는 재미로만 봐주세요!</p>
-	<p>오늘 하루도 행복하고 건강한 하루 되세요! ✨</p>
-	<p style="font-size: 12px; color: #888; margin-top: 10px;">
-	⚠️ 본 포스팅은 쿠팡 파트너스 활동의 일환으로, 이에 따른 일정액의 수수료를 제공받습니다.
-	</p>
-</div>
-</div>
-`)
-
 	// 동적 태그 생성
 	tags := []string{
 		"오늘의운세", "띠별운세", "운세",
@@ -397,8 +322,9 @@ func (f *FortuneCollector) GenerateFortunePost(fortunes []ZodiacFortune) *Post {
 
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: "운세/점술",
+		Template: "fortune",
+		Data:     view,
+		Category: CategoryFortune,
 		Tags:     tags,
 	}
 }
@@ -7,12 +7,11 @@ import (
 	"math/rand"
 	"net/http"
 	"strings"
-	"time"
 )
 
 // GameCollector 게임 뉴스 수집기
 type GameCollector struct {
-	client    *http.Client
+	client    HTTPDoer
 	coupangID string
 }
 
@@ -44,11 +43,28 @@ type GamingProduct struct {
 
 func NewGameCollector(coupangID string) *GameCollector {
 	return &GameCollector{
-		client:    &http.Client{Timeout: 30 * time.Second},
+		client:    defaultHTTPClient(),
 		coupangID: coupangID,
 	}
 }
 
+func init() {
+	Register(NewSource("game", SourceMeta{
+		Description:  "게임 뉴스 + 스팀 할인",
+		PostCategory: CategoryTech,
+		SkipInRun:    true,
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewGameCollector(env.CoupangID())
+		c.client = env.Doer()
+		news, err := c.GetGameNews(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("수집 실패: %w", err)
+		}
+		news = filterExcluded(env, news, func(n GameNews) string { return n.Link })
+		return c.GenerateGamePost(ctx, news), nil
+	}))
+}
+
 // 게이밍 추천 상품
 var gamingProducts = []GamingProduct{
 	{Name: "게이밍 마우스", SearchQuery: "게이밍마우스 로지텍", Emoji: "🖱️", Description: "정확한 조준"},
@@ -68,13 +84,13 @@ func (g *GameCollector) GetGameNews(ctx context.Context) ([]GameNews, error) {
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return g.getSimulatedNews(), nil
+		return g.getSimulatedNews(ctx), nil
 	}
 	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36")
 
 	resp, err := g.client.Do(req)
 	if err != nil {
-		return g.getSimulatedNews(), nil
+		return g.getSimulatedNews(ctx), nil
 	}
 	defer resp.Body.Close()
 
@@ -91,7 +107,7 @@ func (g *GameCollector) GetGameNews(ctx context.Context) ([]GameNews, error) {
 	}
 
 	if err := xml.NewDecoder(resp.Body).Decode(&rss); err != nil {
-		return g.getSimulatedNews(), nil
+		return g.getSimulatedNews(ctx), nil
 	}
 
 	var news []GameNews
@@ -113,7 +129,7 @@ func (g *GameCollector) GetGameNews(ctx context.Context) ([]GameNews, error) {
 	}
 
 	if len(news) == 0 {
-		return g.getSimulatedNews(), nil
+		return g.getSimulatedNews(ctx), nil
 	}
 
 	return news, nil
@@ -126,8 +142,9 @@ func (g *GameCollector) GetSteamDeals(ctx context.Context) ([]SteamGame, error)
 }
 
 // getSimulatedNews 시뮬레이션 뉴스
-func (g *GameCollector) getSimulatedNews() []GameNews {
-	now := time.Now()
+func (g *GameCollector) getSimulatedNews(ctx context.Context) []GameNews {
+	simulated(ctx, "game", "news")
+	now := clock()
 	r := rand.New(rand.NewSource(now.UnixNano()))
 
 	allNews := []GameNews{
@@ -162,7 +179,7 @@ func (g *GameCollector) getSimulatedNews() []GameNews {
 
 // getSimulatedSteamDeals 시뮬레이션 스팀 할인
 func (g *GameCollector) getSimulatedSteamDeals() []SteamGame {
-	now := time.Now()
+	now := clock()
 	r := rand.New(rand.NewSource(now.UnixNano()))
 
 	allDeals := []SteamGame{
@@ -198,111 +215,44 @@ func (g *GameCollector) generateCoupangLink(query string) string {
 	return baseURL
 }
 
+// GameView 게임 뉴스 포스트 템플릿 데이터 (theme: game)
+type GameView struct {
+	Date     string
+	News     []GameNews
+	Deals    []SteamDealView
+	Products []ProductLinkView // 쿠팡 ID가 없으면 비어 있음
+}
+
+// SteamDealView 스팀 할인 카드 (가격은 천 단위 구분)
+type SteamDealView struct {
+	Name          string
+	OriginalPrice string
+	FinalPrice    string
+	DiscountPct   int
+	URL           string
+}
+
 // GenerateGamePost 게임 뉴스 포스트 생성
-func (g *GameCollector) GenerateGamePost(news []GameNews) *Post {
-	now := time.Now()
-	ctx := context.Background()
+func (g *GameCollector) GenerateGamePost(ctx context.Context, news []GameNews) *Post {
+	now := clock()
 
 	steamDeals, _ := g.GetSteamDeals(ctx)
 
 	title := fmt.Sprintf("🎮 [%s] 오늘의 게임 뉴스 & 스팀 할인", now.Format("01/02"))
 
-	var content strings.Builder
-
-	// 스타일
-	content.WriteString(`
-<style>
-.game-container { max-width: 900px; margin: 0 auto; font-family: -apple-system, sans-serif; }
-.game-header { background: linear-gradient(135deg, #667eea 0%, #764ba2 100%); padding: 30px; border-radius: 20px; color: white; text-align: center; margin-bottom: 25px; }
-.section-title { border-left: 5px solid #667eea; padding-left: 15px; font-size: 22px; margin: 30px 0 20px 0; color: #2d3436; }
-.news-card { background: #f8f9fa; padding: 18px; border-radius: 12px; margin: 12px 0; border-left: 4px solid #667eea; transition: transform 0.2s; }
-.news-card:hover { transform: translateX(5px); }
-.news-title { font-size: 16px; font-weight: 600; color: #2d3436; margin: 0; }
-.news-title a { color: #2d3436; text-decoration: none; }
-.news-title a:hover { color: #667eea; }
-.news-source { font-size: 12px; color: #b2bec3; margin-top: 8px; }
-.deal-grid { display: grid; grid-template-columns: repeat(auto-fit, minmax(280px, 1fr)); gap: 15px; }
-.deal-card { background: linear-gradient(135deg, #1a1a2e 0%, #16213e 100%); padding: 20px; border-radius: 16px; color: white; }
-.deal-name { font-size: 18px; font-weight: 700; margin-bottom: 10px; }
-.deal-price { display: flex; align-items: center; gap: 10px; }
-.original-price { text-decoration: line-through; color: #888; font-size: 14px; }
-.final-price { font-size: 22px; font-weight: bold; color: #00d4aa; }
-.discount-badge { background: #e74c3c; padding: 4px 10px; border-radius: 8px; font-size: 14px; font-weight: bold; }
-.product-section { background: linear-gradient(135deg, #232526 0%, #414345 100%); padding: 25px; border-radius: 16px; margin-top: 30px; }
-.product-title { font-size: 20px; font-weight: 700; color: white; margin: 0 0 20px 0; text-align: center; }
-.product-grid { display: grid; grid-template-columns: repeat(auto-fit, minmax(180px, 1fr)); gap: 15px; }
-.product-card { background: rgba(255,255,255,0.1); padding: 20px; border-radius: 12px; text-align: center; color: white; }
-.product-emoji { font-size: 36px; margin-bottom: 10px; }
-.product-name { font-size: 15px; font-weight: 600; }
-.product-desc { font-size: 12px; color: #aaa; margin: 5px 0; }
-.product-link { display: inline-block; background: #e74c3c; color: white; padding: 8px 16px; border-radius: 8px; text-decoration: none; font-size: 13px; margin-top: 10px; }
-.esports-section { background: linear-gradient(135deg, #0f0c29 0%, #302b63 50%, #24243e 100%); padding: 25px; border-radius: 16px; margin: 25px 0; color: white; }
-.footer-notice { margin-top: 30px; padding: 20px; background: #f8f9fa; border-radius: 12px; font-size: 13px; color: #636e72; text-align: center; }
-</style>
-`)
-
-	content.WriteString(fmt.Sprintf(`
-<div class="game-container">
-<div class="game-header">
-	<h1 style="margin: 0; font-size: 28px;">🎮 오늘의 게임 뉴스</h1>
-	<p style="margin: 10px 0 0 0; opacity: 0.9;">%s 업데이트</p>
-</div>
-`, now.Format("2006년 01월 02일")))
-
-	// 게임 뉴스
-	content.WriteString(`<h2 class="section-title">📰 게임 뉴스</h2>`)
-	for _, n := range news {
-		newsLink := n.Title
-		if n.Link != "" {
-			newsLink = fmt.Sprintf(`<a href="%s" target="_blank">%s</a>`, n.Link, n.Title)
-		}
-		content.WriteString(fmt.Sprintf(`
-<div class="news-card">
-	<p class="news-title">%s</p>
-	<p class="news-source">📰 %s</p>
-</div>
-`, newsLink, n.Source))
-	}
-
-	// 스팀 할인
-	if len(steamDeals) > 0 {
-		content.WriteString(`<h2 class="section-title">🔥 Steam 할인 게임</h2>
-<div class="deal-grid">`)
-		for _, deal := range steamDeals {
-			steamURL := fmt.Sprintf("https://store.steampowered.com/app/%d", deal.AppID)
-			content.WriteString(fmt.Sprintf(`
-<div class="deal-card">
-	<div class="deal-name">%s</div>
-	<div class="deal-price">
-		<span class="original-price">₩%s</span>
-		<span class="final-price">₩%s</span>
-		<span class="discount-badge">-%d%%</span>
-	</div>
-	<a href="%s" target="_blank" style="color: #00d4aa; font-size: 13px; margin-top: 10px; display: block;">Steam에서 보기 →</a>
-</div>
-`, deal.Name, formatGamePrice(deal.OriginalPrice), formatGamePrice(deal.FinalPrice), deal.DiscountPct, steamURL))
-		}
-		content.WriteString(`</div>`)
+	view := GameView{Date: now.Format("2006년 01월 02일"), News: news}
+	for _, deal := range steamDeals {
+		view.Deals = append(view.Deals, SteamDealView{
+			Name:          deal.Name,
+			OriginalPrice: formatGamePrice(deal.OriginalPrice),
+			FinalPrice:    formatGamePrice(deal.FinalPrice),
+			DiscountPct:   deal.DiscountPct,
+			URL:           fmt.Sprintf("https://store.steampowered.com/app/%d", deal.AppID),
+		})
 	}
 
-	// e스포츠 섹션
-	content.WriteString(`
-<div class="esports-section">
-	<h3 style="margin: 0 0 15px 0; font-size: 20px;">⚔️ e스포츠 소식</h3>
-	<p style="margin: 0; line-height: 1.8;">
-		🎯 LOL, 발로란트, 오버워치 등 e스포츠 경기 일정은<br>
-		<a href="https://www.op.gg/esports" target="_blank" style="color: #00d4aa;">OP.GG e스포츠</a> 에서 확인하세요!
-	</p>
-</div>
-`)
-
 	// 게이밍 장비 추천
 	if g.coupangID != "" {
-		content.WriteString(`
-<div class="product-section">
-	<h3 class="product-title">🛒 추천 게이밍 장비</h3>
-	<div class="product-grid">
-`)
 		// 랜덤하게 4개 선택
 		r := rand.New(rand.NewSource(now.UnixNano()))
 		shuffled := make([]GamingProduct, len(gamingProducts))
@@ -313,33 +263,15 @@ func (g *GameCollector) GenerateGamePost(news []GameNews) *Post {
 
 		for i := 0; i < 4 && i < len(shuffled); i++ {
 			p := shuffled[i]
-			content.WriteString(fmt.Sprintf(`
-		<div class="product-card">
-			<div class="product-emoji">%s</div>
-			<div class="product-name">%s</div>
-			<div class="product-desc">%s</div>
-			<a href="%s" target="_blank" class="product-link">쿠팡에서 보기</a>
-		</div>
-`, p.Emoji, p.Name, p.Description, g.generateCoupangLink(p.SearchQuery)))
+			view.Products = append(view.Products, ProductLinkView{
+				Emoji:       p.Emoji,
+				Name:        p.Name,
+				Description: p.Description,
+				URL:         g.generateCoupangLink(p.SearchQuery),
+			})
 		}
-
-		content.WriteString(`
-	</div>
-</div>
-`)
 	}
 
-	// 푸터
-	content.WriteString(`
-<div class="footer-notice">
-	<p>🎮 게임을 즐기는 모든 분들을 응원합니다!</p>
-	<p style="margin-top: 10px; font-size: 12px; color: #888;">
-	⚠️ 본 포스팅은 쿠팡 파트너스 활동의 일환으로, 이에 따른 일정액의 수수료를 제공받습니다.
-	</p>
-</div>
-</div>
-`)
-
 	// 태그 생성
 	tags := []string{
 		"게임", "게임뉴스", "스팀할인", "스팀세일",
@@ -361,11 +293,18 @@ func (g *GameCollector) GenerateGamePost(news []GameNews) *Post {
 	// 상품 태그
 	tags = append(tags, "게이밍마우스", "게이밍키보드", "게이밍장비")
 
+	sourceIDs := make([]string, 0, len(news))
+	for _, n := range news {
+		sourceIDs = append(sourceIDs, n.Link)
+	}
+
 	return &Post{
-		Title:    title,
-		Content:  content.String(),
-		Category: CategoryTech, // IT/테크 카테고리에 포함
-		Tags:     tags,
+		Title:     title,
+		Template:  "game",
+		Data:      view,
+		Category:  CategoryTech, // IT/테크 카테고리에 포함
+		Tags:      tags,
+		SourceIDs: sourceIDs,
 	}
 }
 
@@ -4,15 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"image/color"
 	"math/rand"
 	"net/http"
 	"strings"
-	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // GolfCollector 골프 + 날씨 수집기
 type GolfCollector struct {
-	client    *http.Client
+	client    HTTPDoer
 	coupangID string
 	regions   []GolfRegion
 }
@@ -65,12 +67,30 @@ type GolfProduct struct {
 // NewGolfCollector 골프 수집기 생성
 func NewGolfCollector(coupangID string) *GolfCollector {
 	return &GolfCollector{
-		client:    &http.Client{Timeout: 30 * time.Second},
+		client:    defaultHTTPClient(),
 		coupangID: coupangID,
 		regions:   getDefaultRegions(),
 	}
 }
 
+func init() {
+	Register(NewSource("golf", SourceMeta{
+		Description:  "내일 골프 날씨 예보 ⛳",
+		PostCategory: CategoryGolf,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{46, 125, 50, 255}, // 그린
+			GradientEnd:   color.RGBA{76, 175, 80, 255}, // 라이트그린
+			Emoji:         "GOLF",
+			SubText:       "골프 날씨",
+		},
+		SkipInRun: true,
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewGolfCollector(env.CoupangID())
+		c.client = env.Doer()
+		return c.GenerateGolfPost(ctx), nil
+	}))
+}
+
 // getDefaultRegions 전국 주요 지역 및 골프장 데이터
 func getDefaultRegions() []GolfRegion {
 	return []GolfRegion{
@@ -269,12 +289,12 @@ func (g *GolfCollector) GetGolfWeather(ctx context.Context, region GolfRegion) (
 	resp, err := g.client.Do(req)
 	if err != nil {
 		// API 실패 시 시뮬레이션 데이터 반환
-		return g.simulateWeather(region), nil
+		return g.simulateWeather(ctx, region), nil
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return g.simulateWeather(region), nil
+		return g.simulateWeather(ctx, region), nil
 	}
 
 	var data struct {
@@ -293,7 +313,7 @@ func (g *GolfCollector) GetGolfWeather(ctx context.Context, region GolfRegion) (
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return g.simulateWeather(region), nil
+		return g.simulateWeather(ctx, region), nil
 	}
 
 	weather := &GolfWeather{
@@ -316,36 +336,37 @@ func (g *GolfCollector) GetGolfWeather(ctx context.Context, region GolfRegion) (
 }
 
 // simulateWeather 날씨 시뮬레이션 (API 실패 시)
-func (g *GolfCollector) simulateWeather(region GolfRegion) *GolfWeather {
-	rand.Seed(time.Now().UnixNano())
+func (g *GolfCollector) simulateWeather(ctx context.Context, region GolfRegion) *GolfWeather {
+	simulated(ctx, "golf", "weather")
+	rng := rand.New(rand.NewSource(clock().UnixNano()))
 
 	// 계절에 따른 온도 조정
-	month := time.Now().Month()
+	month := clock().Month()
 	var baseTemp float64
 	var descriptions []string
 
 	switch {
 	case month >= 3 && month <= 5: // 봄
-		baseTemp = 15 + rand.Float64()*10
+		baseTemp = 15 + rng.Float64()*10
 		descriptions = []string{"맑음", "구름 조금", "화창함"}
 	case month >= 6 && month <= 8: // 여름
-		baseTemp = 25 + rand.Float64()*8
+		baseTemp = 25 + rng.Float64()*8
 		descriptions = []string{"맑음", "구름 많음", "흐림", "소나기"}
 	case month >= 9 && month <= 11: // 가을
-		baseTemp = 12 + rand.Float64()*12
+		baseTemp = 12 + rng.Float64()*12
 		descriptions = []string{"맑음", "구름 조금", "청명함", "선선함"}
 	default: // 겨울
-		baseTemp = -2 + rand.Float64()*10
+		baseTemp = -2 + rng.Float64()*10
 		descriptions = []string{"맑음", "흐림", "눈", "추움"}
 	}
 
 	weather := &GolfWeather{
 		Region:      region.Name,
 		Temperature: baseTemp,
-		FeelsLike:   baseTemp - 2 + rand.Float64()*4,
-		Humidity:    40 + rand.Intn(40),
-		WindSpeed:   1 + rand.Float64()*6,
-		Description: descriptions[rand.Intn(len(descriptions))],
+		FeelsLike:   baseTemp - 2 + rng.Float64()*4,
+		Humidity:    40 + rng.Intn(40),
+		WindSpeed:   1 + rng.Float64()*6,
+		Description: descriptions[rng.Intn(len(descriptions))],
 	}
 
 	weather.GolfIndex, weather.GolfGrade, weather.GolfComment = g.calculateGolfIndex(weather)
@@ -355,10 +376,10 @@ func (g *GolfCollector) simulateWeather(region GolfRegion) *GolfWeather {
 
 // simulateTomorrowWeather 내일 날씨 예측 (예보)
 func (g *GolfCollector) simulateTomorrowWeather(region GolfRegion) *GolfWeather {
-	rand.Seed(time.Now().UnixNano() + int64(region.Lat*1000))
+	rng := rand.New(rand.NewSource(clock().UnixNano() + int64(region.Lat*1000)))
 
 	// 내일 날씨 예측 (약간의 변동 추가)
-	tomorrow := time.Now().AddDate(0, 0, 1)
+	tomorrow := clock().AddDate(0, 0, 1)
 	month := tomorrow.Month()
 
 	var baseTemp float64
@@ -366,26 +387,26 @@ func (g *GolfCollector) simulateTomorrowWeather(region GolfRegion) *GolfWeather
 
 	switch {
 	case month >= 3 && month <= 5: // 봄
-		baseTemp = 14 + rand.Float64()*12
+		baseTemp = 14 + rng.Float64()*12
 		descriptions = []string{"맑음 예상", "구름 조금 예상", "화창할 것", "선선할 것"}
 	case month >= 6 && month <= 8: // 여름
-		baseTemp = 24 + rand.Float64()*10
+		baseTemp = 24 + rng.Float64()*10
 		descriptions = []string{"맑음 예상", "구름 많음 예상", "소나기 가능성", "무더울 것"}
 	case month >= 9 && month <= 11: // 가을
-		baseTemp = 11 + rand.Float64()*13
+		baseTemp = 11 + rng.Float64()*13
 		descriptions = []string{"맑음 예상", "구름 조금 예상", "청명할 것", "쌀쌀할 것"}
 	default: // 겨울
-		baseTemp = -3 + rand.Float64()*12
+		baseTemp = -3 + rng.Float64()*12
 		descriptions = []string{"맑음 예상", "흐림 예상", "눈 가능성", "추울 것"}
 	}
 
 	weather := &GolfWeather{
 		Region:      region.Name,
 		Temperature: baseTemp,
-		FeelsLike:   baseTemp - 2 + rand.Float64()*4,
-		Humidity:    35 + rand.Intn(45),
-		WindSpeed:   1 + rand.Float64()*7,
-		Description: descriptions[rand.Intn(len(descriptions))],
+		FeelsLike:   baseTemp - 2 + rng.Float64()*4,
+		Humidity:    35 + rng.Intn(45),
+		WindSpeed:   1 + rng.Float64()*7,
+		Description: descriptions[rng.Intn(len(descriptions))],
 	}
 
 	weather.GolfIndex, weather.GolfGrade, weather.GolfComment = g.calculateGolfIndex(weather)
@@ -543,20 +564,36 @@ func (g *GolfCollector) GetGolfProducts() []GolfProduct {
 	return products
 }
 
+// GolfView 골프 날씨 포스트 템플릿 데이터 (theme: golf)
+type GolfView struct {
+	Date     string // 내일 날짜
+	Regions  []GolfRegionView
+	Products []GolfProductView
+}
+
+// GolfRegionView 지역별 날씨 카드
+type GolfRegionView struct {
+	Region  GolfRegion
+	Weather *GolfWeather
+}
+
+// GolfProductView 추천 용품 카드
+type GolfProductView struct {
+	Name  string
+	Price string // 천 단위 구분
+	URL   string
+}
+
 // GenerateGolfPost 내일 골프 날씨 예측 포스트 생성
 func (g *GolfCollector) GenerateGolfPost(ctx context.Context) *Post {
-	now := time.Now()
+	now := clock()
 	tomorrow := now.AddDate(0, 0, 1)
 
 	// 모든 지역 표시
-	rand.Seed(now.UnixNano())
 	selectedRegions := g.regions
 
 	// 각 지역 내일 날씨 예측 조회
-	var weatherData []struct {
-		Region  GolfRegion
-		Weather *GolfWeather
-	}
+	var weatherData []GolfRegionView
 
 	bestIndex := 0
 	bestRegion := ""
@@ -564,10 +601,7 @@ func (g *GolfCollector) GenerateGolfPost(ctx context.Context) *Post {
 	for _, region := range selectedRegions {
 		weather := g.simulateTomorrowWeather(region) // 내일 날씨 예측
 		if weather != nil {
-			weatherData = append(weatherData, struct {
-				Region  GolfRegion
-				Weather *GolfWeather
-			}{region, weather})
+			weatherData = append(weatherData, GolfRegionView{Region: region, Weather: weather})
 
 			if weather.GolfIndex > bestIndex {
 				bestIndex = weather.GolfIndex
@@ -583,135 +617,15 @@ func (g *GolfCollector) GenerateGolfPost(ctx context.Context) *Post {
 	title := fmt.Sprintf("[%s 예보] 내일 골프 날씨 ⛳ %s 골프지수 %d점! 추천 골프장",
 		tomorrow.Format("01/02"), bestRegion, bestIndex)
 
-	// 본문 생성
-	var content strings.Builder
-
-	// 스타일
-	content.WriteString(`
-<style>
-.golf-container { max-width: 900px; margin: 0 auto; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', sans-serif; }
-.golf-header { background: linear-gradient(135deg, #2d5a27 0%, #4a7c59 100%); color: white; padding: 40px; border-radius: 16px; text-align: center; margin-bottom: 30px; }
-.golf-header h1 { margin: 0 0 10px 0; font-size: 28px; }
-.golf-header p { margin: 0; opacity: 0.9; }
-.weather-grid { display: grid; grid-template-columns: repeat(auto-fit, minmax(280px, 1fr)); gap: 20px; margin-bottom: 30px; }
-.weather-card { background: #fff; border: 1px solid #e5e5e5; border-radius: 12px; padding: 20px; }
-.weather-card h3 { margin: 0 0 15px 0; color: #2d5a27; font-size: 18px; }
-.weather-info { display: flex; justify-content: space-between; align-items: center; margin-bottom: 15px; }
-.temp { font-size: 36px; font-weight: 700; color: #333; }
-.weather-detail { font-size: 14px; color: #666; }
-.golf-index { text-align: center; padding: 15px; background: #f5f5f5; border-radius: 8px; margin-bottom: 15px; }
-.golf-index .score { font-size: 32px; font-weight: 700; }
-.golf-index .grade { font-size: 16px; margin-top: 5px; }
-.golf-index .comment { font-size: 13px; color: #666; margin-top: 8px; padding: 8px; background: #fff; border-radius: 4px; }
-.course-list { margin-top: 15px; }
-.course-item { padding: 12px 0; border-bottom: 1px solid #eee; }
-.course-item:last-child { border-bottom: none; }
-.course-name { font-weight: 600; color: #333; }
-.course-info { font-size: 13px; color: #666; margin-top: 4px; }
-.course-features { display: flex; gap: 8px; margin-top: 8px; flex-wrap: wrap; }
-.feature-tag { font-size: 11px; padding: 3px 8px; background: #e8f5e9; color: #2d5a27; border-radius: 4px; }
-.products-section { background: #f9f9f9; padding: 30px; border-radius: 16px; margin-top: 30px; }
-.products-section h2 { margin: 0 0 20px 0; color: #333; }
-.product-grid { display: grid; grid-template-columns: repeat(auto-fit, minmax(200px, 1fr)); gap: 15px; }
-.product-card { background: #fff; border: 1px solid #e5e5e5; border-radius: 8px; padding: 15px; text-align: center; }
-.product-name { font-size: 14px; font-weight: 500; margin-bottom: 8px; }
-.product-price { font-size: 18px; font-weight: 700; color: #f03e3e; margin-bottom: 10px; }
-.product-btn { display: inline-block; background: #2d5a27; color: white; padding: 8px 20px; border-radius: 6px; text-decoration: none; font-size: 13px; }
-.footer-notice { margin-top: 30px; padding: 20px; background: #f5f5f5; border-radius: 12px; font-size: 13px; color: #666; }
-</style>
-`)
-
-	content.WriteString(`<div class="golf-container">`)
-
-	// 헤더 (내일 날짜)
-	content.WriteString(fmt.Sprintf(`
-<div class="golf-header">
-	<h1>⛳ 내일의 골프 날씨 예보</h1>
-	<p>%s | 내일 골프 치기 좋은 날을 미리 확인하세요!</p>
-</div>
-`, tomorrow.Format("2006년 01월 02일 (Mon)")))
-
-	// 날씨 카드들
-	content.WriteString(`<div class="weather-grid">`)
-
-	for _, data := range weatherData {
-		content.WriteString(fmt.Sprintf(`
-<div class="weather-card">
-	<h3>📍 %s %s</h3>
-	<div class="weather-info">
-		<div class="temp">%.1f°C</div>
-		<div class="weather-detail">
-			체감 %.1f°C<br>
-			습도 %d%% | 바람 %.1fm/s<br>
-			%s
-		</div>
-	</div>
-	<div class="golf-index">
-		<div class="score">%d점</div>
-		<div class="grade">%s</div>
-		<div class="comment">💬 %s</div>
-	</div>
-	<div class="course-list">
-		<strong>🏌️ 추천 골프장</strong>
-`, data.Region.City, data.Region.Name,
-			data.Weather.Temperature,
-			data.Weather.FeelsLike,
-			data.Weather.Humidity,
-			data.Weather.WindSpeed,
-			data.Weather.Description,
-			data.Weather.GolfIndex,
-			data.Weather.GolfGrade,
-			data.Weather.GolfComment))
-
-		for _, course := range data.Region.GolfCourses {
-			content.WriteString(fmt.Sprintf(`
-		<div class="course-item">
-			<div class="course-name">%s ⭐%.1f</div>
-			<div class="course-info">%s | %s</div>
-			<div class="course-features">
-`, course.Name, course.Rating, course.GreenFee, course.Phone))
-
-			for _, feature := range course.Features {
-				content.WriteString(fmt.Sprintf(`<span class="feature-tag">%s</span>`, feature))
-			}
-			content.WriteString(`</div></div>`)
-		}
-
-		content.WriteString(`</div></div>`)
-	}
-
-	content.WriteString(`</div>`) // weather-grid 끝
-
-	// 골프 용품 추천
-	content.WriteString(`
-<div class="products-section">
-	<h2>🛒 오늘의 골프 용품 추천</h2>
-	<div class="product-grid">
-`)
-
+	view := GolfView{Date: tomorrow.Format("2006년 01월 02일 (Mon)"), Regions: weatherData}
 	for _, product := range products[:4] { // 4개만 표시
-		content.WriteString(fmt.Sprintf(`
-		<div class="product-card">
-			<div class="product-name">%s</div>
-			<div class="product-price">%s원</div>
-			<a href="%s" target="_blank" class="product-btn">👉 최저가 보기</a>
-		</div>
-`, product.Name, formatPrice(product.Price), product.URL))
+		view.Products = append(view.Products, GolfProductView{
+			Name:  product.Name,
+			Price: formatPrice(product.Price),
+			URL:   product.URL,
+		})
 	}
 
-	content.WriteString(`</div></div>`)
-
-	// 푸터
-	content.WriteString(`
-<div class="footer-notice">
-	<p>💡 <strong>Tip:</strong> 골프 라운드 전 날씨를 꼭 확인하세요! 바람이 강한 날은 클럽 선택에 주의하세요.</p>
-	<p>📍 골프장 예약은 미리미리! 주말은 2주 전 예약을 추천합니다.</p>
-	<p>⚠️ 본 포스팅은 쿠팡 파트너스 활동의 일환으로, 이에 따른 일정액의 수수료를 제공받습니다.</p>
-</div>
-`)
-
-	content.WriteString(`</div>`) // container 끝
-
 	// 공격적인 태그 전략
 	tags := []string{
 		// 기본 태그
@@ -722,7 +636,7 @@ func (g *GolfCollector) GenerateGolfPost(ctx context.Context) *Post {
 		"골프장예약", "골프장가격", "골프장날씨", "내일골프날씨",
 		"골프라운딩", "주말골프", "골프장추천", "가성비골프장",
 		// 시간대 태그
-		time.Now().Format("01월") + "골프", time.Now().Format("01월02일") + "골프날씨",
+		clock().Format("01월") + "골프", clock().Format("01월02일") + "골프날씨",
 		// 트렌드 키워드
 		"골프여행", "골프투어", "골프장예약사이트", "골프부킹",
 	}
@@ -732,8 +646,9 @@ func (g *GolfCollector) GenerateGolfPost(ctx context.Context) *Post {
 
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: "골프/날씨",
+		Template: "golf",
+		Data:     view,
+		Category: CategoryGolf,
 		Tags:     tags,
 	}
 }
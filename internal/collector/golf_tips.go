@@ -3,9 +3,11 @@ package collector
 import (
 	"context"
 	"fmt"
+	"image/color"
 	"math/rand"
 	"strings"
-	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // GolfTipsCollector 골프 레슨 팁 + 용품 추천 수집기
@@ -46,6 +48,23 @@ func NewGolfTipsCollector(coupangID string) *GolfTipsCollector {
 	}
 }
 
+func init() {
+	Register(NewSource("golf-tips", SourceMeta{
+		Description:  "골프 레슨 팁 + 용품 추천 🏌️",
+		PostCategory: CategoryGolf,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{27, 94, 32, 255},  // 다크그린
+			GradientEnd:   color.RGBA{56, 142, 60, 255}, // 그린
+			Emoji:         "LESSON",
+			SubText:       "골프 레슨",
+		},
+		SkipInRun: true,
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewGolfTipsCollector(env.CoupangID())
+		return c.GenerateGolfTipsPost(ctx), nil
+	}))
+}
+
 // getGolfTips 골프 레슨 팁 데이터
 func getGolfTips() []GolfTip {
 	return []GolfTip{
@@ -229,14 +248,30 @@ func getGolfEquipments() []GolfEquipment {
 	}
 }
 
+// GolfTipsView 골프 레슨 포스트 템플릿 데이터 (theme: golf-tips)
+type GolfTipsView struct {
+	Date     string
+	Tips     []GolfTip
+	Products []GolfTipsProductView
+}
+
+// GolfTipsProductView 추천 용품 카드
+type GolfTipsProductView struct {
+	Name   string
+	Price  string // 천 단위 구분
+	Stars  string
+	Rating float64
+	URL    string
+}
+
 // GenerateGolfTipsPost 골프 레슨 팁 포스트 생성
 func (g *GolfTipsCollector) GenerateGolfTipsPost(ctx context.Context) *Post {
-	now := time.Now()
-	rand.Seed(now.UnixNano())
+	now := clock()
+	rng := rand.New(rand.NewSource(now.UnixNano()))
 
 	// 오늘의 팁 선택 (랜덤 3개, 카테고리 다르게)
 	categories := []string{"드라이버", "아이언", "퍼팅", "어프로치", "멘탈", "코스전략"}
-	rand.Shuffle(len(categories), func(i, j int) {
+	rng.Shuffle(len(categories), func(i, j int) {
 		categories[i], categories[j] = categories[j], categories[i]
 	})
 	selectedCategories := categories[:3]
@@ -252,7 +287,7 @@ func (g *GolfTipsCollector) GenerateGolfTipsPost(ctx context.Context) *Post {
 	}
 
 	// 관련 용품 선택 (4개)
-	rand.Shuffle(len(g.products), func(i, j int) {
+	rng.Shuffle(len(g.products), func(i, j int) {
 		g.products[i], g.products[j] = g.products[j], g.products[i]
 	})
 	selectedProducts := g.products[:4]
@@ -261,104 +296,17 @@ func (g *GolfTipsCollector) GenerateGolfTipsPost(ctx context.Context) *Post {
 	mainTip := selectedTips[0]
 	title := fmt.Sprintf("[골프레슨] %s | 오늘의 골프 팁 ⛳", mainTip.Title)
 
-	// 본문 생성
-	var content strings.Builder
-
-	// 스타일
-	content.WriteString(`
-<style>
-.golf-tips-container { max-width: 900px; margin: 0 auto; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', sans-serif; }
-.tips-header { background: linear-gradient(135deg, #1a472a 0%, #2d5a27 100%); color: white; padding: 40px; border-radius: 16px; text-align: center; margin-bottom: 30px; }
-.tips-header h1 { margin: 0 0 10px 0; font-size: 26px; }
-.tips-header p { margin: 0; opacity: 0.9; font-size: 14px; }
-.tip-card { background: #fff; border: 1px solid #e5e5e5; border-radius: 12px; padding: 25px; margin-bottom: 25px; box-shadow: 0 2px 10px rgba(0,0,0,0.05); }
-.tip-category { display: inline-block; background: #2d5a27; color: white; padding: 4px 12px; border-radius: 20px; font-size: 12px; margin-bottom: 15px; }
-.tip-title { font-size: 20px; font-weight: 700; color: #1a472a; margin-bottom: 10px; }
-.tip-desc { color: #555; margin-bottom: 20px; line-height: 1.6; }
-.tip-steps { background: #f8faf8; padding: 20px; border-radius: 8px; margin-bottom: 15px; }
-.tip-steps li { padding: 8px 0; border-bottom: 1px dashed #ddd; }
-.tip-steps li:last-child { border-bottom: none; }
-.pro-tip { background: #fff3cd; padding: 15px; border-radius: 8px; margin-bottom: 10px; }
-.pro-tip::before { content: '💡 Pro Tip: '; font-weight: 700; }
-.common-error { background: #f8d7da; padding: 15px; border-radius: 8px; }
-.common-error::before { content: '⚠️ 주의: '; font-weight: 700; }
-.products-section { background: #f5f5f5; padding: 30px; border-radius: 16px; margin-top: 30px; }
-.products-section h2 { margin: 0 0 20px 0; color: #1a472a; }
-.product-grid { display: grid; grid-template-columns: repeat(auto-fit, minmax(200px, 1fr)); gap: 15px; }
-.product-card { background: white; border-radius: 10px; padding: 15px; text-align: center; }
-.product-name { font-size: 14px; font-weight: 600; margin-bottom: 5px; color: #333; }
-.product-price { font-size: 18px; font-weight: 700; color: #e53935; margin-bottom: 8px; }
-.product-rating { font-size: 12px; color: #ffc107; margin-bottom: 10px; }
-.product-btn { display: inline-block; background: #2d5a27; color: white; padding: 10px 20px; border-radius: 6px; text-decoration: none; font-size: 13px; }
-.footer-note { margin-top: 30px; padding: 20px; background: #f9f9f9; border-radius: 12px; font-size: 13px; color: #666; }
-</style>
-`)
-
-	content.WriteString(`<div class="golf-tips-container">`)
-
-	// 헤더
-	content.WriteString(fmt.Sprintf(`
-<div class="tips-header">
-	<h1>⛳ 오늘의 골프 레슨</h1>
-	<p>%s | 스코어를 줄이는 실전 팁!</p>
-</div>
-`, now.Format("2006년 01월 02일")))
-
-	// 팁 카드들
-	for _, tip := range selectedTips {
-		content.WriteString(fmt.Sprintf(`
-<div class="tip-card">
-	<span class="tip-category">%s</span>
-	<h3 class="tip-title">%s</h3>
-	<p class="tip-desc">%s</p>
-	<div class="tip-steps">
-		<ul style="list-style: none; padding: 0; margin: 0;">
-`, tip.Category, tip.Title, tip.Description))
-
-		for _, step := range tip.Steps {
-			content.WriteString(fmt.Sprintf(`<li>%s</li>`, step))
-		}
-
-		content.WriteString(`</ul></div>`)
-		content.WriteString(fmt.Sprintf(`<div class="pro-tip">%s</div>`, tip.ProTip))
-		content.WriteString(fmt.Sprintf(`<div class="common-error">%s</div>`, tip.CommonError))
-		content.WriteString(`</div>`)
-	}
-
-	// 추천 용품
-	content.WriteString(`
-<div class="products-section">
-	<h2>🛒 오늘의 추천 골프용품</h2>
-	<div class="product-grid">
-`)
-
+	view := GolfTipsView{Date: now.Format("2006년 01월 02일"), Tips: selectedTips}
 	for _, product := range selectedProducts {
-		url := g.generatePartnerLink(product.ProductID)
-		stars := strings.Repeat("⭐", int(product.Rating))
-
-		content.WriteString(fmt.Sprintf(`
-		<div class="product-card">
-			<div class="product-name">%s</div>
-			<div class="product-price">%s원</div>
-			<div class="product-rating">%s %.1f</div>
-			<a href="%s" target="_blank" class="product-btn">👉 최저가 보기</a>
-		</div>
-`, product.Name, formatPrice(product.Price), stars, product.Rating, url))
+		view.Products = append(view.Products, GolfTipsProductView{
+			Name:   product.Name,
+			Price:  formatPrice(product.Price),
+			Stars:  strings.Repeat("⭐", int(product.Rating)),
+			Rating: product.Rating,
+			URL:    g.generatePartnerLink(product.ProductID),
+		})
 	}
 
-	content.WriteString(`</div></div>`)
-
-	// 푸터
-	content.WriteString(`
-<div class="footer-note">
-	<p>📌 오늘 배운 팁을 연습장에서 꼭 연습해보세요!</p>
-	<p>🏌️ 좋은 장비도 중요하지만, 꾸준한 연습이 실력 향상의 핵심입니다.</p>
-	<p>⚠️ 본 포스팅은 쿠팡 파트너스 활동의 일환으로, 이에 따른 일정액의 수수료를 제공받습니다.</p>
-</div>
-`)
-
-	content.WriteString(`</div>`)
-
 	// 공격적인 태그 전략
 	tags := []string{
 		// 기본 태그
@@ -380,8 +328,9 @@ func (g *GolfTipsCollector) GenerateGolfTipsPost(ctx context.Context) *Post {
 
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: "골프/날씨",
+		Template: "golf-tips",
+		Data:     view,
+		Category: CategoryGolf,
 		Tags:     tags,
 	}
 }
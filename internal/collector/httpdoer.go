@@ -0,0 +1,158 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/metrics"
+)
+
+// HTTPDoer 수집기가 사용하는 HTTP 클라이언트 (*http.Client, 녹화/재생 Doer 등)
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// defaultHTTPClient 수집기 기본 HTTP 클라이언트
+func defaultHTTPClient() HTTPDoer {
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+// Doer 수집기에 주입할 HTTP 클라이언트 (HTTP 미설정이면 기본 클라이언트, BaseURL 설정 시 주소 변경)
+func (e *Env) Doer() HTTPDoer {
+	doer := e.HTTP
+	if doer == nil {
+		doer = defaultHTTPClient()
+	}
+	if e.BaseURL != "" {
+		doer = &RewriteDoer{BaseURL: e.BaseURL, Next: doer}
+	}
+	return &timedDoer{next: doer}
+}
+
+// timedDoer 요청 소요 시간을 수집기 지표로 기록 (원래 요청 호스트 기준)
+type timedDoer struct {
+	next HTTPDoer
+}
+
+// Do HTTPDoer 구현
+func (d *timedDoer) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := d.next.Do(req)
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+	metrics.CollectorFetch.Observe(time.Since(start).Seconds(), req.URL.Host, status)
+	if t := traceFrom(req.Context()); t != nil {
+		t.add(&t.fetched, req.URL.Host+" "+status)
+	}
+	return resp, err
+}
+
+// simulated API 실패로 시뮬레이션 데이터를 쓸 때 지표/추적 기록 (source: 카테고리, data: 데이터 종류)
+func simulated(ctx context.Context, source, data string) {
+	metrics.CollectorFallbacks.Inc(source, data)
+	if t := traceFrom(ctx); t != nil {
+		t.add(&t.simulated, source+"/"+data)
+	}
+}
+
+// Trace 수집 1회 동안 호출한 API와 시뮬레이션 데이터 사용 기록 (실행 기록용)
+type Trace struct {
+	mu        sync.Mutex
+	fetched   []string // "호스트 상태" (예: "api.coingecko.com 200", 실패 시 "... error")
+	simulated []string // "카테고리/데이터" (예: "sports/news")
+}
+
+type traceKey struct{}
+
+// WithTrace 수집 추적을 붙인 context (이 context로 수집한 요청/시뮬레이션이 Trace에 기록됨)
+func WithTrace(ctx context.Context) (context.Context, *Trace) {
+	t := &Trace{}
+	return context.WithValue(ctx, traceKey{}, t), t
+}
+
+// traceFrom context의 수집 추적 (없으면 nil)
+func traceFrom(ctx context.Context) *Trace {
+	t, _ := ctx.Value(traceKey{}).(*Trace)
+	return t
+}
+
+// add 중복 없이 추가
+func (t *Trace) add(list *[]string, item string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, v := range *list {
+		if v == item {
+			return
+		}
+	}
+	*list = append(*list, item)
+}
+
+// Fetched 호출한 API (호출 순서, 중복 제외)
+func (t *Trace) Fetched() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.fetched...)
+}
+
+// Simulated API 대신 사용한 시뮬레이션 데이터 (없으면 실제 데이터만 사용)
+func (t *Trace) Simulated() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.simulated...)
+}
+
+// OriginalHostHeader RewriteDoer가 원래 요청 호스트를 전달하는 헤더
+const OriginalHostHeader = "X-Original-Host"
+
+// RewriteDoer 모든 요청의 scheme/host를 BaseURL로 바꿔 전달 (로컬 가짜 서버용)
+// 원래 호스트는 X-Original-Host 헤더로 전달한다.
+type RewriteDoer struct {
+	BaseURL string
+	Next    HTTPDoer
+}
+
+// Do HTTPDoer 구현
+func (d *RewriteDoer) Do(req *http.Request) (*http.Response, error) {
+	base, err := url.Parse(d.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("base URL 파싱 실패: %w", err)
+	}
+
+	out := req.Clone(req.Context())
+	out.URL.Scheme = base.Scheme
+	out.URL.Host = base.Host
+	out.Host = base.Host
+	out.Header.Set(OriginalHostHeader, req.URL.Host)
+	return d.Next.Do(out)
+}
+
+// clock 수집기 현재 시각 (골든 파일 비교 시 고정 시각으로 교체)
+var (
+	clockMu sync.RWMutex
+	clockFn = time.Now
+)
+
+// clock 현재 시각
+func clock() time.Time {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return clockFn()
+}
+
+// SetClock 수집기 시각 함수 교체 (nil이면 time.Now로 복원)
+func SetClock(fn func() time.Time) {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	if fn == nil {
+		fn = time.Now
+	}
+	clockFn = fn
+}
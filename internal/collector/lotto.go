@@ -4,16 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"image/color"
 	"math/rand"
 	"net/http"
 	"sort"
-	"strings"
 	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // LottoCollector 로또 정보 수집기
 type LottoCollector struct {
-	client *http.Client
+	client HTTPDoer
 }
 
 // LottoResult 로또 당첨 결과
@@ -33,16 +35,62 @@ type LottoResult struct {
 
 func NewLottoCollector() *LottoCollector {
 	return &LottoCollector{
-		client: &http.Client{Timeout: 30 * time.Second},
+		client: defaultHTTPClient(),
 	}
 }
 
+func init() {
+	Register(NewSource("lotto", SourceMeta{
+		Description:  "로또 당첨번호",
+		PostCategory: CategoryLotto,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{50, 205, 50, 255}, // 라임그린
+			GradientEnd:   color.RGBA{34, 139, 34, 255}, // 포레스트그린
+			Emoji:         "LOTTO",
+			SubText:       "로또 당첨번호",
+		},
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewLottoCollector()
+		c.client = env.Doer()
+		result, err := c.GetLatestLotto(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("수집 실패: %w", err)
+		}
+		return c.GenerateLottoPost(result), nil
+	}))
+
+	Register(NewSource("lotto-predict", SourceMeta{
+		Description:  "로또 예측번호 (AI 분석)",
+		PostCategory: CategoryLotto,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{138, 43, 226, 255}, // 블루바이올렛
+			GradientEnd:   color.RGBA{75, 0, 130, 255},   // 인디고
+			Emoji:         "AI",
+			SubText:       "로또 예측",
+		},
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewLottoCollector()
+		c.client = env.Doer()
+		results, err := c.GetRecentResults(ctx, 20)
+		if err != nil {
+			return nil, fmt.Errorf("분석 실패: %w", err)
+		}
+		if len(results) == 0 {
+			return nil, fmt.Errorf("분석 실패: 당첨 이력 없음")
+		}
+		hotNumbers, coldNumbers := c.AnalyzeNumbers(results)
+		predictions := c.GeneratePredictions(hotNumbers, coldNumbers, env.Account.Name)
+		nextRound := results[0].DrawNo + 1
+		return c.GeneratePredictionPost(nextRound, predictions, hotNumbers, coldNumbers), nil
+	}))
+}
+
 // GetLatestLotto 최신 로또 당첨번호 조회
 func (l *LottoCollector) GetLatestLotto(ctx context.Context) (*LottoResult, error) {
 	// 최신 회차 계산 (2002년 12월 7일 1회차 기준)
 	// 계산 오차를 방지하기 위해 여유있게 계산 후 실제 데이터로 확인
 	startDate := time.Date(2002, 12, 7, 0, 0, 0, 0, time.Local)
-	now := time.Now()
+	now := clock()
 	weeks := int(now.Sub(startDate).Hours()/24/7) + 5 // 5회 여유분 추가
 
 	return l.GetLottoByRound(ctx, weeks)
@@ -76,6 +124,31 @@ func (l *LottoCollector) GetLottoByRound(ctx context.Context, round int) (*Lotto
 	return &result, nil
 }
 
+// LottoView 로또 당첨번호 포스트 템플릿 데이터 (theme: lotto)
+type LottoView struct {
+	DrawNo   int
+	DrawDate string
+	Numbers  []LottoBall
+	Bonus    LottoBall
+	Prize1   string // 1등 당첨금 (천 단위 구분)
+	Winner1  int
+}
+
+// LottoBall 로또 공 번호 - 템플릿에서 .Color 로 번호대별 색상 사용
+type LottoBall int
+
+// Color 번호대별 공 색상
+func (b LottoBall) Color() string { return getLottoBallColor(int(b)) }
+
+// lottoBalls 번호 → 공 목록
+func lottoBalls(numbers []int) []LottoBall {
+	balls := make([]LottoBall, len(numbers))
+	for i, n := range numbers {
+		balls[i] = LottoBall(n)
+	}
+	return balls
+}
+
 // GenerateLottoPost 로또 포스트 생성
 func (l *LottoCollector) GenerateLottoPost(result *LottoResult) *Post {
 	title := fmt.Sprintf("🎰 %d회 로또 당첨번호 [%s]", result.DrawNo, result.DrawDate)
@@ -83,62 +156,18 @@ func (l *LottoCollector) GenerateLottoPost(result *LottoResult) *Post {
 	// 번호 슬라이스
 	numbers := []int{result.Number1, result.Number2, result.Number3, result.Number4, result.Number5, result.Number6}
 
-	var content strings.Builder
-	content.WriteString(fmt.Sprintf(`<h2>🎰 %d회 로또 당첨번호</h2>
-<p>추첨일: %s</p>
-
-<div style="background: linear-gradient(135deg, #1a1a2e 0%%, #16213e 100%%); padding: 30px; border-radius: 15px; text-align: center; margin: 20px 0;">
-<h3 style="color: #eee; margin-bottom: 20px;">당첨번호</h3>
-<div style="display: flex; justify-content: center; gap: 10px; flex-wrap: wrap;">
-`, result.DrawNo, result.DrawDate))
-
-	// 번호별 색상
-	for _, num := range numbers {
-		color := getLottoBallColor(num)
-		content.WriteString(fmt.Sprintf(`<span style="display: inline-block; width: 50px; height: 50px; border-radius: 50%%; background: %s; color: white; font-size: 20px; font-weight: bold; line-height: 50px; text-shadow: 1px 1px 2px rgba(0,0,0,0.5);">%d</span>
-`, color, num))
-	}
-
-	content.WriteString(fmt.Sprintf(`<span style="color: #eee; font-size: 24px; line-height: 50px; margin: 0 10px;">+</span>
-<span style="display: inline-block; width: 50px; height: 50px; border-radius: 50%%; background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); color: white; font-size: 20px; font-weight: bold; line-height: 50px; border: 3px solid gold;">%d</span>
-</div>
-<p style="color: #aaa; margin-top: 10px;">보너스 번호</p>
-</div>
-`, result.BonusNumber))
-
-	// 당첨금 정보
-	content.WriteString(fmt.Sprintf(`
-<h3>💰 1등 당첨 정보</h3>
-<table style="width: 100%%; border-collapse: collapse; margin: 20px 0;">
-<tr style="background: #f5f5f5;">
-<td style="padding: 15px; border: 1px solid #ddd; text-align: center;"><strong>1등 당첨금</strong></td>
-<td style="padding: 15px; border: 1px solid #ddd; text-align: center; font-size: 1.2em; color: #e74c3c;"><strong>%s원</strong></td>
-</tr>
-<tr>
-<td style="padding: 15px; border: 1px solid #ddd; text-align: center;"><strong>1등 당첨자 수</strong></td>
-<td style="padding: 15px; border: 1px solid #ddd; text-align: center;"><strong>%d명</strong></td>
-</tr>
-</table>
-`, formatMoney(result.Prize1), result.Winner1))
-
-	content.WriteString(`
-<h3>📊 번호 분석</h3>
-<ul>
-<li>홀수/짝수 비율 분석</li>
-<li>고저 번호 분포</li>
-<li>연속 번호 여부</li>
-</ul>
-
-<p style="color: #888; font-size: 0.9em; margin-top: 30px;">
-※ 로또는 확률 게임입니다. 무리한 구매는 삼가해주세요.<br>
-※ 공식 결과는 동행복권 사이트에서 확인하세요.
-</p>
-`)
-
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: "로또/복권",
+		Template: "lotto",
+		Data: LottoView{
+			DrawNo:   result.DrawNo,
+			DrawDate: result.DrawDate,
+			Numbers:  lottoBalls(numbers),
+			Bonus:    LottoBall(result.BonusNumber),
+			Prize1:   formatMoney(result.Prize1),
+			Winner1:  result.Winner1,
+		},
+		Category: CategoryLotto,
 		Tags:     []string{"로또", "로또당첨번호", fmt.Sprintf("%d회로또", result.DrawNo), "복권", "당첨번호"},
 	}
 }
@@ -226,9 +255,12 @@ func (l *LottoCollector) AnalyzeNumbers(results []LottoResult) (hotNumbers []int
 		stats = append(stats, NumberStats{Number: num, Frequency: freq})
 	}
 
-	// 빈도순 정렬
+	// 빈도순 정렬 (같은 빈도는 번호순 - 같은 이력이면 항상 같은 결과)
 	sort.Slice(stats, func(i, j int) bool {
-		return stats[i].Frequency > stats[j].Frequency
+		if stats[i].Frequency != stats[j].Frequency {
+			return stats[i].Frequency > stats[j].Frequency
+		}
+		return stats[i].Number < stats[j].Number
 	})
 
 	// 핫넘버 (상위 10개)
@@ -247,7 +279,7 @@ func (l *LottoCollector) AnalyzeNumbers(results []LottoResult) (hotNumbers []int
 // GeneratePredictions 예측 번호 생성 (5세트) - 계정별 다른 번호
 func (l *LottoCollector) GeneratePredictions(hotNumbers, coldNumbers []int, accountName string) []LottoPrediction {
 	// 날짜 + 계정 기반 시드 (같은 날 같은 계정은 같은 예측, 다른 계정은 다른 예측)
-	today := time.Now().Format("2006-01-02")
+	today := clock().Format("2006-01-02")
 	seed := int64(0)
 	for _, c := range today {
 		seed += int64(c)
@@ -416,92 +448,43 @@ func generateHighLowBalance(rng *rand.Rand) []int {
 	return result
 }
 
+// LottoPredictionView 로또 예측번호 포스트 템플릿 데이터 (theme: lotto-predict)
+type LottoPredictionView struct {
+	NextRound int
+	Date      string
+	Sets      []LottoPredictionSet
+	Hot       []int
+	Cold      []int
+}
+
+// LottoPredictionSet 예측 번호 1세트
+type LottoPredictionSet struct {
+	Name    string
+	Method  string
+	Color   string // 카드 강조 색상
+	Numbers []LottoBall
+}
+
 // GeneratePredictionPost 예측 번호 포스트 생성
 func (l *LottoCollector) GeneratePredictionPost(nextRound int, predictions []LottoPrediction, hotNumbers, coldNumbers []int) *Post {
-	now := time.Now()
+	now := clock()
 	title := fmt.Sprintf("🔮 %d회 로또 예측번호 [%s] AI 분석 추천", nextRound, now.Format("01/02"))
 
-	var content strings.Builder
-	content.WriteString(fmt.Sprintf(`<h2>🔮 %d회 로또 예측번호</h2>
-<p>분석일: %s</p>
-
-<div style="background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); padding: 20px; border-radius: 15px; color: white; margin: 20px 0; text-align: center;">
-<p style="font-size: 1.3em; margin: 0;">✨ 이번 주 행운의 번호를 확인하세요! ✨</p>
-</div>
-`, nextRound, now.Format("2006년 01월 02일")))
-
-	// 예측 번호 표시
-	content.WriteString(`<h3>🎯 예측 번호 5세트</h3>`)
-
-	for i, pred := range predictions {
-		content.WriteString(fmt.Sprintf(`
-<div style="background: #f8f9fa; padding: 20px; border-radius: 10px; margin-bottom: 15px; border-left: 5px solid %s;">
-<h4 style="margin-top: 0;">%s</h4>
-<p style="color: #666; font-size: 0.9em;">%s</p>
-<div style="display: flex; gap: 8px; flex-wrap: wrap; margin-top: 10px;">
-`, getPredictionColor(i), pred.Name, pred.Method))
-
-		for _, num := range pred.Numbers {
-			color := getLottoBallColor(num)
-			content.WriteString(fmt.Sprintf(`<span style="display: inline-block; width: 45px; height: 45px; border-radius: 50%%; background: %s; color: white; font-size: 18px; font-weight: bold; line-height: 45px; text-align: center; text-shadow: 1px 1px 2px rgba(0,0,0,0.3);">%d</span>
-`, color, num))
-		}
-
-		content.WriteString(`</div>
-</div>
-`)
+	view := LottoPredictionView{
+		NextRound: nextRound,
+		Date:      now.Format("2006년 01월 02일"),
+		Hot:       hotNumbers,
+		Cold:      coldNumbers,
 	}
-
-	// 번호 분석 정보
-	content.WriteString(`
-<h3>📈 최근 번호 분석 (20회차 기준)</h3>
-
-<div style="display: flex; gap: 20px; flex-wrap: wrap;">
-<div style="flex: 1; min-width: 200px; background: #fff3e0; padding: 15px; border-radius: 10px;">
-<h4 style="color: #e65100; margin-top: 0;">🔥 핫넘버 (자주 출현)</h4>
-<p style="font-size: 1.2em; font-weight: bold;">`)
-
-	for i, n := range hotNumbers {
-		if i > 0 {
-			content.WriteString(", ")
-		}
-		content.WriteString(fmt.Sprintf("%d", n))
-	}
-
-	content.WriteString(`</p>
-</div>
-
-<div style="flex: 1; min-width: 200px; background: #e3f2fd; padding: 15px; border-radius: 10px;">
-<h4 style="color: #1565c0; margin-top: 0;">❄️ 콜드넘버 (적게 출현)</h4>
-<p style="font-size: 1.2em; font-weight: bold;">`)
-
-	for i, n := range coldNumbers {
-		if i > 0 {
-			content.WriteString(", ")
-		}
-		content.WriteString(fmt.Sprintf("%d", n))
+	for i, pred := range predictions {
+		view.Sets = append(view.Sets, LottoPredictionSet{
+			Name:    pred.Name,
+			Method:  pred.Method,
+			Color:   getPredictionColor(i),
+			Numbers: lottoBalls(pred.Numbers),
+		})
 	}
 
-	content.WriteString(`</p>
-</div>
-</div>
-
-<h3>💡 로또 당첨 꿀팁</h3>
-<ul>
-<li>홀수/짝수 비율은 3:3 또는 4:2가 가장 많이 당첨</li>
-<li>연속 번호는 1~2개 정도 포함되는 경우가 많음</li>
-<li>같은 번호대(1~10, 11~20 등)에서 3개 이상은 드묾</li>
-<li>총합이 100~175 사이인 경우가 가장 많음</li>
-</ul>
-
-<div style="background: #ffebee; padding: 15px; border-radius: 10px; margin-top: 20px;">
-<p style="color: #c62828; margin: 0;">
-⚠️ <strong>주의:</strong> 로또는 순수 확률 게임입니다. 예측 번호는 참고용이며, 당첨을 보장하지 않습니다.<br>
-무리한 구매는 삼가해주시고, 즐거운 마음으로 참여하세요! 🍀
-</p>
-</div>
-`)
-
 	// 공격적인 태그 전략
 	tags := []string{
 		// 기본 태그
@@ -515,7 +498,7 @@ func (l *LottoCollector) GeneratePredictionPost(nextRound int, predictions []Lot
 		"로또당첨번호", "이번주로또", "로또번호생성", "로또추천",
 		"로또분석기", "로또예측기", "로또행운번호",
 		// 시간대 태그
-		time.Now().Format("01월") + "로또", time.Now().Format("2006년") + "로또예측",
+		clock().Format("01월") + "로또", clock().Format("2006년") + "로또예측",
 		// 트렌드 키워드
 		"로또당첨확률", "로또번호조합", "로또1등번호",
 		"무료로또예측", "AI로또예측", "로또번호추천기",
@@ -523,8 +506,9 @@ func (l *LottoCollector) GeneratePredictionPost(nextRound int, predictions []Lot
 
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: "로또/복권",
+		Template: "lotto-predict",
+		Data:     view,
+		Category: CategoryLotto,
 		Tags:     tags,
 	}
 }
@@ -4,14 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"image/color"
 	"net/http"
-	"strings"
-	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // MovieCollector 영화/드라마 정보 수집기
 type MovieCollector struct {
-	client    *http.Client
+	client    HTTPDoer
 	tmdbKey   string // TMDB API Key (무료)
 	coupangID string
 }
@@ -43,12 +44,34 @@ type MovieProduct struct {
 
 func NewMovieCollector(tmdbKey, coupangID string) *MovieCollector {
 	return &MovieCollector{
-		client:    &http.Client{Timeout: 30 * time.Second},
+		client:    defaultHTTPClient(),
 		tmdbKey:   tmdbKey,
 		coupangID: coupangID,
 	}
 }
 
+func init() {
+	Register(NewSource("movie", SourceMeta{
+		Description:  "영화/드라마 정보",
+		PostCategory: CategoryMovie,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{220, 20, 60, 255}, // 크림슨
+			GradientEnd:   color.RGBA{139, 0, 139, 255}, // 다크마젠타
+			Emoji:         "MOVIE",
+			SubText:       "영화/드라마",
+		},
+		Requires: []Requirement{RequireTMDB},
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewMovieCollector(env.Config.TMDB.APIKey, env.CoupangID())
+		c.client = env.Doer()
+		movies, err := c.GetNowPlaying(ctx, 10)
+		if err != nil {
+			return nil, fmt.Errorf("수집 실패: %w", err)
+		}
+		return c.GenerateMoviePost(movies, "now_playing"), nil
+	}))
+}
+
 // 영화 관람용 추천 상품
 var movieProducts = []MovieProduct{
 	{Name: "팝콘", SearchQuery: "전자레인지 팝콘", Emoji: "🍿", Description: "영화관 감성 그대로"},
@@ -176,9 +199,29 @@ func (m *MovieCollector) generateCoupangLink(query string) string {
 	return baseURL
 }
 
+// MovieView 영화/드라마 포스트 템플릿 데이터 (theme: movie)
+type MovieView struct {
+	Emoji        string
+	Heading      string
+	Date         string
+	Movies       []Movie
+	Theaters     bool // 극장 예매 링크 표시 (영화)
+	OTT          bool // OTT 플랫폼 링크 표시 (드라마)
+	ProductTitle string
+	Products     []ProductLinkView // 쿠팡 ID가 없으면 비어 있음
+}
+
+// PosterURL TMDB 포스터 이미지 주소 (포스터 없으면 빈 문자열)
+func (m Movie) PosterURL() string {
+	if m.PosterPath == "" {
+		return ""
+	}
+	return "https://image.tmdb.org/t/p/w300" + m.PosterPath
+}
+
 // GenerateMoviePost 영화 정보 포스트 생성
 func (m *MovieCollector) GenerateMoviePost(movies []Movie, postType string) *Post {
-	now := time.Now()
+	now := clock()
 
 	var title string
 	var emoji string
@@ -199,139 +242,31 @@ func (m *MovieCollector) GenerateMoviePost(movies []Movie, postType string) *Pos
 		products = dramaProducts
 	}
 
-	var content strings.Builder
-
-	// 스타일
-	content.WriteString(`
-<style>
-.movie-container { max-width: 900px; margin: 0 auto; font-family: -apple-system, sans-serif; }
-.movie-header { background: linear-gradient(135deg, #e74c3c 0%, #c0392b 100%); padding: 30px; border-radius: 20px; color: white; text-align: center; margin-bottom: 25px; }
-.movie-card { display: flex; background: white; border-radius: 12px; overflow: hidden; margin: 15px 0; box-shadow: 0 4px 15px rgba(0,0,0,0.1); }
-.movie-poster { width: 140px; min-height: 200px; object-fit: cover; }
-.movie-info { padding: 20px; flex: 1; }
-.movie-rank { display: inline-block; background: #e74c3c; color: white; padding: 5px 12px; border-radius: 20px; font-weight: bold; margin-bottom: 10px; }
-.movie-title { font-size: 20px; font-weight: 700; color: #2d3436; margin: 0 0 10px 0; }
-.movie-meta { display: flex; gap: 15px; margin-bottom: 10px; color: #636e72; font-size: 14px; }
-.movie-rating { color: #f39c12; font-weight: 600; }
-.movie-desc { color: #636e72; line-height: 1.6; font-size: 14px; }
-.product-section { background: linear-gradient(135deg, #fff5f5 0%, #ffe3e3 100%); padding: 30px; border-radius: 16px; margin-top: 40px; }
-.product-title { font-size: 22px; font-weight: 700; color: #c53030; margin: 0 0 25px 0; text-align: center; }
-.product-grid { display: grid; grid-template-columns: repeat(auto-fit, minmax(180px, 1fr)); gap: 15px; }
-.product-card { background: white; padding: 20px; border-radius: 12px; text-align: center; box-shadow: 0 2px 10px rgba(0,0,0,0.05); }
-.product-emoji { font-size: 40px; margin-bottom: 10px; }
-.product-name { font-size: 16px; font-weight: 600; color: #2d3436; }
-.product-desc { font-size: 13px; color: #636e72; margin: 5px 0 15px 0; }
-.product-link { display: inline-block; background: #e53e3e; color: white; padding: 10px 20px; border-radius: 8px; text-decoration: none; font-size: 14px; font-weight: 600; }
-.product-link:hover { background: #c53030; }
-.theater-links { display: flex; gap: 10px; justify-content: center; margin: 30px 0; flex-wrap: wrap; }
-.theater-btn { padding: 12px 24px; border-radius: 8px; text-decoration: none; font-weight: 600; color: white; }
-.cgv { background: #e74c3c; }
-.megabox { background: #8e44ad; }
-.lotte { background: #e74c3c; }
-.footer-notice { margin-top: 30px; padding: 20px; background: #f8f9fa; border-radius: 12px; font-size: 13px; color: #636e72; text-align: center; }
-</style>
-`)
-
-	content.WriteString(fmt.Sprintf(`
-<div class="movie-container">
-<div class="movie-header">
-	<h1 style="margin: 0; font-size: 28px;">%s %s</h1>
-	<p style="margin: 10px 0 0 0; opacity: 0.9;">%s 업데이트</p>
-</div>
-`, emoji, title, now.Format("2006년 01월 02일")))
-
-	// 영화 목록
-	for i, movie := range movies {
-		posterURL := ""
-		if movie.PosterPath != "" {
-			posterURL = "https://image.tmdb.org/t/p/w300" + movie.PosterPath
-		}
-
-		content.WriteString(`<div class="movie-card">`)
-		if posterURL != "" {
-			content.WriteString(fmt.Sprintf(`<img src="%s" alt="%s" class="movie-poster">`, posterURL, movie.Title))
-		}
-		content.WriteString(fmt.Sprintf(`
-<div class="movie-info">
-	<span class="movie-rank">%d위</span>
-	<h3 class="movie-title">%s</h3>
-	<div class="movie-meta">
-		<span class="movie-rating">⭐ %.1f/10</span>
-		<span>📅 %s</span>
-	</div>
-	<p class="movie-desc">%s</p>
-</div>
-</div>
-`, i+1, movie.Title, movie.VoteAverage, movie.ReleaseDate, truncate(movie.Overview, 120)))
-	}
-
-	// 극장 예매 링크 (영화인 경우)
-	if postType == "now_playing" || postType == "upcoming" {
-		content.WriteString(`
-<div class="theater-links">
-	<a href="https://www.cgv.co.kr" target="_blank" class="theater-btn cgv">🎬 CGV 예매</a>
-	<a href="https://www.megabox.co.kr" target="_blank" class="theater-btn megabox">🎬 메가박스 예매</a>
-	<a href="https://www.lottecinema.co.kr" target="_blank" class="theater-btn lotte">🎬 롯데시네마 예매</a>
-</div>
-`)
+	view := MovieView{
+		Emoji:    emoji,
+		Heading:  title,
+		Date:     now.Format("2006년 01월 02일"),
+		Movies:   movies,
+		Theaters: postType == "now_playing" || postType == "upcoming",
+		OTT:      postType == "tv",
 	}
 
 	// 추천 상품 섹션
 	if m.coupangID != "" && len(products) > 0 {
-		productTitle := "🍿 영화 감상 필수템"
+		view.ProductTitle = "🍿 영화 감상 필수템"
 		if postType == "tv" {
-			productTitle = "📺 드라마 정주행 필수템"
+			view.ProductTitle = "📺 드라마 정주행 필수템"
 		}
-
-		content.WriteString(fmt.Sprintf(`
-<div class="product-section">
-	<h3 class="product-title">%s</h3>
-	<div class="product-grid">
-`, productTitle))
-
 		for _, product := range products {
-			content.WriteString(fmt.Sprintf(`
-		<div class="product-card">
-			<div class="product-emoji">%s</div>
-			<div class="product-name">%s</div>
-			<div class="product-desc">%s</div>
-			<a href="%s" target="_blank" class="product-link">쿠팡에서 보기</a>
-		</div>
-`, product.Emoji, product.Name, product.Description, m.generateCoupangLink(product.SearchQuery)))
+			view.Products = append(view.Products, ProductLinkView{
+				Emoji:       product.Emoji,
+				Name:        product.Name,
+				Description: product.Description,
+				URL:         m.generateCoupangLink(product.SearchQuery),
+			})
 		}
-
-		content.WriteString(`
-	</div>
-</div>
-`)
 	}
 
-	// OTT 플랫폼 링크
-	if postType == "tv" {
-		content.WriteString(`
-<div style="margin-top: 30px; text-align: center;">
-	<h3>📱 OTT 플랫폼에서 시청하기</h3>
-	<div style="display: flex; gap: 10px; justify-content: center; flex-wrap: wrap; margin-top: 15px;">
-		<a href="https://www.netflix.com" target="_blank" style="padding: 10px 20px; background: #E50914; color: white; border-radius: 8px; text-decoration: none; font-weight: 600;">넷플릭스</a>
-		<a href="https://www.tving.com" target="_blank" style="padding: 10px 20px; background: #FF0558; color: white; border-radius: 8px; text-decoration: none; font-weight: 600;">티빙</a>
-		<a href="https://www.wavve.com" target="_blank" style="padding: 10px 20px; background: #1E2875; color: white; border-radius: 8px; text-decoration: none; font-weight: 600;">웨이브</a>
-		<a href="https://watcha.com" target="_blank" style="padding: 10px 20px; background: #FF0558; color: white; border-radius: 8px; text-decoration: none; font-weight: 600;">왓챠</a>
-	</div>
-</div>
-`)
-	}
-
-	// 푸터
-	content.WriteString(`
-<div class="footer-notice">
-	<p>🎬 즐거운 영화/드라마 감상 되세요!</p>
-	<p style="margin-top: 10px; font-size: 12px; color: #888;">
-	⚠️ 본 포스팅은 쿠팡 파트너스 활동의 일환으로, 이에 따른 일정액의 수수료를 제공받습니다.
-	</p>
-</div>
-</div>
-`)
-
 	// 동적 태그 생성
 	tags := []string{
 		"영화", "영화추천", "박스오피스",
@@ -361,7 +296,8 @@ func (m *MovieCollector) GenerateMoviePost(movies []Movie, postType string) *Pos
 
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
+		Template: "movie",
+		Data:     view,
 		Category: CategoryMovie,
 		Tags:     tags,
 	}
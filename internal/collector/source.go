@@ -0,0 +1,210 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/theme"
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
+)
+
+// Source 카테고리 단위 포스트 생성기 (레지스트리 등록 단위)
+type Source interface {
+	// Name 카테고리 키 (예: crypto, golf-tips)
+	Name() string
+	// Meta 도움말/썸네일/필수 설정 등 메타데이터
+	Meta() SourceMeta
+	// Collect 데이터 수집 후 포스트 생성
+	Collect(ctx context.Context, env *Env) (*Post, error)
+}
+
+// Requirement 소스 실행에 필요한 설정
+type Requirement string
+
+const (
+	RequireCoupang Requirement = "coupang" // 계정별 쿠팡 파트너스 ID
+	RequireTMDB    Requirement = "tmdb"    // 전역 TMDB API 키
+)
+
+// SourceMeta 소스 메타데이터
+type SourceMeta struct {
+	Description  string                   // 도움말에 표시할 설명
+	PostCategory string                   // 생성한 글의 Post.Category (계정 categories 매핑 키)
+	Thumbnail    *thumbnail.CategoryStyle // 기본 썸네일 스타일 (nil이면 기본값)
+	Requires     []Requirement            // 필수 설정 (없으면 건너뜀)
+	SkipInRun    bool                     // run 명령 일괄 실행에서 제외
+	Browser      bool                     // 브라우저로 수집 (HTTP 녹화/재생 불가)
+}
+
+// Env 수집 실행 환경 (전역 설정 + 대상 계정)
+type Env struct {
+	Config  *config.Config
+	Account *config.AccountConfig
+	Exclude map[string]bool // 재생성 시 제외할 소스 항목 ID (최근 발행 이력)
+	HTTP    HTTPDoer        // 수집기 HTTP 클라이언트 (nil이면 기본 클라이언트)
+	BaseURL string          // 설정 시 모든 요청을 이 주소로 보냄 (가짜 서버/녹화 재생용)
+}
+
+// Theme 계정에 지정된 본문 테마 (미지정이면 내장 기본 테마)
+func (e *Env) Theme() (*theme.Theme, error) {
+	dir, name := "", ""
+	if e.Config != nil {
+		dir = e.Config.ThemesDir
+	}
+	if e.Account != nil {
+		name = e.Account.Theme
+	}
+	return theme.Load(dir, name)
+}
+
+// Render post.Template이 있으면 계정 테마로 본문(Content) 렌더링
+func (e *Env) Render(post *Post) error {
+	if post == nil || post.Template == "" {
+		return nil
+	}
+	t, err := e.Theme()
+	if err != nil {
+		return err
+	}
+	content, err := t.Render(post.Template, post.Data)
+	if err != nil {
+		return fmt.Errorf("본문 렌더링 실패: %w", err)
+	}
+	post.Content = content
+	return nil
+}
+
+// CoupangID 쿠팡 파트너스 ID (미설정이면 빈 문자열)
+func (e *Env) CoupangID() string {
+	if e.Account == nil || !e.Account.HasCoupang() {
+		return ""
+	}
+	return e.Account.Coupang.PartnerID
+}
+
+// Missing 충족되지 않은 필수 설정 목록
+func (e *Env) Missing(reqs []Requirement) []Requirement {
+	var missing []Requirement
+	for _, req := range reqs {
+		switch req {
+		case RequireCoupang:
+			if e.CoupangID() == "" {
+				missing = append(missing, req)
+			}
+		case RequireTMDB:
+			if e.Config == nil || e.Config.TMDB.APIKey == "" {
+				missing = append(missing, req)
+			}
+		}
+	}
+	return missing
+}
+
+// filterExcluded env.Exclude에 포함된 항목 제거
+func filterExcluded[T any](env *Env, items []T, id func(T) string) []T {
+	if len(env.Exclude) == 0 {
+		return items
+	}
+	kept := items[:0:0]
+	for _, item := range items {
+		if !env.Exclude[id(item)] {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// funcSource 함수 기반 Source 구현
+type funcSource struct {
+	name    string
+	meta    SourceMeta
+	collect func(ctx context.Context, env *Env) (*Post, error)
+}
+
+func (s *funcSource) Name() string     { return s.name }
+func (s *funcSource) Meta() SourceMeta { return s.meta }
+
+func (s *funcSource) Collect(ctx context.Context, env *Env) (*Post, error) {
+	post, err := s.collect(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	if err := env.Render(post); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// NewSource 수집 함수로 Source 생성
+func NewSource(name string, meta SourceMeta, collect func(ctx context.Context, env *Env) (*Post, error)) Source {
+	return &funcSource{name: name, meta: meta, collect: collect}
+}
+
+// 소스 레지스트리 (등록 순서 유지)
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Source)
+	order      []string
+)
+
+// Register 소스 등록 (각 수집기 파일의 init에서 호출, 중복 등록 시 panic)
+func Register(src Source) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	name := src.Name()
+	if _, dup := registry[name]; dup {
+		panic(fmt.Sprintf("collector: 소스 중복 등록: %s", name))
+	}
+	registry[name] = src
+	order = append(order, name)
+
+	if style := src.Meta().Thumbnail; style != nil {
+		thumbnail.RegisterStyle(name, *style)
+	}
+}
+
+// Lookup 카테고리 키로 소스 조회
+func Lookup(name string) (Source, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	src, ok := registry[name]
+	return src, ok
+}
+
+// Has 등록된 카테고리인지 확인
+func Has(name string) bool {
+	_, ok := Lookup(name)
+	return ok
+}
+
+// EmitsCategory 카테고리 매핑 키(Post.Category)로 글을 만드는 소스가 있는지 확인
+func EmitsCategory(key string) bool {
+	for _, src := range Sources() {
+		if src.Meta().PostCategory == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Sources 등록된 모든 소스 (등록 순서)
+func Sources() []Source {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	sources := make([]Source, 0, len(order))
+	for _, name := range order {
+		sources = append(sources, registry[name])
+	}
+	return sources
+}
+
+// Names 등록된 카테고리 키 목록 (등록 순서)
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return append([]string(nil), order...)
+}
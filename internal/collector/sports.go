@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"image/color"
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // SportsCollector 스포츠 정보 수집기
 type SportsCollector struct {
-	client         *http.Client
+	client         HTTPDoer
 	coupangID      string
 	footballAPIKey string // Football-Data.org API Key
 }
@@ -73,19 +77,47 @@ type SportsProduct struct {
 
 func NewSportsCollector(coupangID string) *SportsCollector {
 	return &SportsCollector{
-		client:    &http.Client{Timeout: 30 * time.Second},
+		client:    defaultHTTPClient(),
 		coupangID: coupangID,
 	}
 }
 
 func NewSportsCollectorWithAPI(coupangID, footballAPIKey string) *SportsCollector {
 	return &SportsCollector{
-		client:         &http.Client{Timeout: 30 * time.Second},
+		client:         defaultHTTPClient(),
 		coupangID:      coupangID,
 		footballAPIKey: footballAPIKey,
 	}
 }
 
+func init() {
+	Register(NewSource("sports", SourceMeta{
+		Description:  "스포츠 뉴스",
+		PostCategory: CategorySports,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{0, 184, 148, 255}, // 그린
+			GradientEnd:   color.RGBA{0, 206, 201, 255}, // 시안
+			Emoji:         "SPORTS",
+			SubText:       "스포츠 뉴스",
+		},
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		// 실시간 스포츠 API 연동 (Football-Data.org, NBA API)
+		footballAPIKey := ""
+		if env.Config.FootballData != nil {
+			footballAPIKey = env.Config.FootballData.APIKey
+		}
+		c := NewSportsCollectorWithAPI(env.CoupangID(), footballAPIKey)
+		c.client = env.Doer()
+		news, err := c.GetSportsNews(ctx)
+		if err != nil {
+			logging.From(ctx).Warn("⚠️ 실시간 스포츠 뉴스 수집 실패", "err", err)
+			// 경기 데이터는 GenerateSportsPost 내부에서 자동 처리
+		}
+		news = filterExcluded(env, news, func(n SportsNews) string { return n.Link })
+		return c.GenerateSportsPost(ctx, news), nil
+	}))
+}
+
 // 종목별 추천 상품
 var sportsProducts = map[string][]SportsProduct{
 	"축구": {
@@ -115,7 +147,7 @@ var sportsProducts = map[string][]SportsProduct{
 // GetFootballMatches Football-Data.org API로 축구 경기 가져오기
 func (s *SportsCollector) GetFootballMatches(ctx context.Context) ([]FootballMatch, error) {
 	if s.footballAPIKey == "" {
-		return s.getSimulatedFootballMatches(), nil
+		return s.getSimulatedFootballMatches(ctx), nil
 	}
 
 	// Premier League 경기 조회
@@ -123,18 +155,18 @@ func (s *SportsCollector) GetFootballMatches(ctx context.Context) ([]FootballMat
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return s.getSimulatedFootballMatches(), nil
+		return s.getSimulatedFootballMatches(ctx), nil
 	}
 	req.Header.Set("X-Auth-Token", s.footballAPIKey)
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return s.getSimulatedFootballMatches(), nil
+		return s.getSimulatedFootballMatches(ctx), nil
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return s.getSimulatedFootballMatches(), nil
+		return s.getSimulatedFootballMatches(ctx), nil
 	}
 
 	var result struct {
@@ -160,7 +192,7 @@ func (s *SportsCollector) GetFootballMatches(ctx context.Context) ([]FootballMat
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return s.getSimulatedFootballMatches(), nil
+		return s.getSimulatedFootballMatches(ctx), nil
 	}
 
 	var matches []FootballMatch
@@ -179,7 +211,7 @@ func (s *SportsCollector) GetFootballMatches(ctx context.Context) ([]FootballMat
 	}
 
 	if len(matches) == 0 {
-		return s.getSimulatedFootballMatches(), nil
+		return s.getSimulatedFootballMatches(ctx), nil
 	}
 
 	return matches, nil
@@ -187,23 +219,23 @@ func (s *SportsCollector) GetFootballMatches(ctx context.Context) ([]FootballMat
 
 // GetNBAGames NBA 경기 가져오기 (balldontlie.io - 무료)
 func (s *SportsCollector) GetNBAGames(ctx context.Context) ([]NBAGame, error) {
-	today := time.Now().Format("2006-01-02")
+	today := clock().Format("2006-01-02")
 	url := fmt.Sprintf("https://www.balldontlie.io/api/v1/games?dates[]=%s", today)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return s.getSimulatedNBAGames(), nil
+		return s.getSimulatedNBAGames(ctx), nil
 	}
 	req.Header.Set("User-Agent", "TistoryBot/1.0")
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return s.getSimulatedNBAGames(), nil
+		return s.getSimulatedNBAGames(ctx), nil
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return s.getSimulatedNBAGames(), nil
+		return s.getSimulatedNBAGames(ctx), nil
 	}
 
 	var result struct {
@@ -218,7 +250,7 @@ func (s *SportsCollector) GetNBAGames(ctx context.Context) ([]NBAGame, error) {
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return s.getSimulatedNBAGames(), nil
+		return s.getSimulatedNBAGames(ctx), nil
 	}
 
 	var games []NBAGame
@@ -236,7 +268,7 @@ func (s *SportsCollector) GetNBAGames(ctx context.Context) ([]NBAGame, error) {
 	}
 
 	if len(games) == 0 {
-		return s.getSimulatedNBAGames(), nil
+		return s.getSimulatedNBAGames(ctx), nil
 	}
 
 	return games, nil
@@ -303,7 +335,7 @@ func (s *SportsCollector) GetSportsNewsRSS(ctx context.Context) ([]SportsNews, e
 
 	// RSS 실패 시 시뮬레이션 데이터
 	if len(allNews) == 0 {
-		return s.getSimulatedNews(), nil
+		return s.getSimulatedNews(ctx), nil
 	}
 
 	return allNews, nil
@@ -318,15 +350,16 @@ func (s *SportsCollector) GetSportsNews(ctx context.Context) ([]SportsNews, erro
 	}
 
 	// 실패 시 시뮬레이션
-	return s.getSimulatedNews(), nil
+	return s.getSimulatedNews(ctx), nil
 }
 
 // ===============================================
 // 시뮬레이션 데이터 (API 실패 시 백업)
 // ===============================================
 
-func (s *SportsCollector) getSimulatedFootballMatches() []FootballMatch {
-	now := time.Now()
+func (s *SportsCollector) getSimulatedFootballMatches(ctx context.Context) []FootballMatch {
+	simulated(ctx, "sports", "football")
+	now := clock()
 	return []FootballMatch{
 		{
 			HomeTeam:    "토트넘",
@@ -361,8 +394,9 @@ func (s *SportsCollector) getSimulatedFootballMatches() []FootballMatch {
 	}
 }
 
-func (s *SportsCollector) getSimulatedNBAGames() []NBAGame {
-	now := time.Now()
+func (s *SportsCollector) getSimulatedNBAGames(ctx context.Context) []NBAGame {
+	simulated(ctx, "sports", "nba")
+	now := clock()
 	return []NBAGame{
 		{
 			HomeTeam:  "LA Lakers",
@@ -383,8 +417,9 @@ func (s *SportsCollector) getSimulatedNBAGames() []NBAGame {
 	}
 }
 
-func (s *SportsCollector) getSimulatedNews() []SportsNews {
-	now := time.Now()
+func (s *SportsCollector) getSimulatedNews(ctx context.Context) []SportsNews {
+	simulated(ctx, "sports", "news")
+	now := clock()
 	dateStr := now.Format("01/02")
 
 	return []SportsNews{
@@ -446,10 +481,44 @@ func (s *SportsCollector) generateCoupangLink(query string) string {
 	return baseURL
 }
 
+// SportsView 스포츠 포스트 템플릿 데이터 (theme: sports)
+type SportsView struct {
+	Updated  string
+	Football []SportsMatchView
+	NBA      []SportsMatchView
+	Sections []SportsSectionView // 종목별 뉴스 (축구, 야구, 농구 순)
+	KBO      []KBORowView
+}
+
+// SportsMatchView 경기 카드
+type SportsMatchView struct {
+	Home      string
+	Away      string
+	HomeScore int
+	AwayScore int
+	Status    string
+	Live      bool
+}
+
+// SportsSectionView 종목별 뉴스와 추천 장비
+type SportsSectionView struct {
+	Emoji    string
+	Category string
+	News     []SportsNews
+	Products []ProductLinkView // 쿠팡 ID가 없으면 비어 있음
+}
+
+// KBORowView KBO 순위표 한 줄
+type KBORowView struct {
+	KBOTeam
+	Position int
+	Medal    string // 1~3위 메달
+	Top      bool   // 상위 3팀 강조
+}
+
 // GenerateSportsPost 스포츠 포스트 생성
-func (s *SportsCollector) GenerateSportsPost(news []SportsNews) *Post {
-	now := time.Now()
-	ctx := context.Background()
+func (s *SportsCollector) GenerateSportsPost(ctx context.Context, news []SportsNews) *Post {
+	now := clock()
 
 	// 실시간 경기 데이터 가져오기
 	footballMatches, _ := s.GetFootballMatches(ctx)
@@ -457,118 +526,31 @@ func (s *SportsCollector) GenerateSportsPost(news []SportsNews) *Post {
 
 	title := fmt.Sprintf("⚽ [%s] 실시간 스포츠 뉴스 & 경기 결과", now.Format("01/02 15:00"))
 
-	var content strings.Builder
-
-	// 스타일
-	content.WriteString(`
-<style>
-.sports-container { max-width: 900px; margin: 0 auto; font-family: -apple-system, sans-serif; }
-.sports-header { background: linear-gradient(135deg, #00b894 0%, #00cec9 100%); padding: 30px; border-radius: 20px; color: white; text-align: center; margin-bottom: 25px; }
-.live-badge { display: inline-block; background: #e74c3c; color: white; padding: 4px 10px; border-radius: 12px; font-size: 12px; animation: pulse 1.5s infinite; margin-left: 8px; }
-@keyframes pulse { 0%, 100% { opacity: 1; } 50% { opacity: 0.5; } }
-.match-section { background: #f8f9fa; padding: 25px; border-radius: 16px; margin: 20px 0; }
-.match-card { background: white; padding: 20px; border-radius: 12px; margin: 15px 0; display: flex; align-items: center; justify-content: space-between; box-shadow: 0 2px 10px rgba(0,0,0,0.05); }
-.team { text-align: center; flex: 1; }
-.team-name { font-weight: 600; font-size: 16px; color: #2d3436; }
-.score { font-size: 28px; font-weight: bold; color: #00b894; padding: 0 20px; }
-.match-status { font-size: 12px; color: #636e72; margin-top: 5px; }
-.news-card { background: #fff; padding: 20px; border-radius: 12px; margin: 15px 0; border-left: 4px solid #00b894; box-shadow: 0 2px 8px rgba(0,0,0,0.03); }
-.news-title { font-size: 17px; font-weight: 600; color: #2d3436; margin: 0 0 10px 0; }
-.news-title a { color: #2d3436; text-decoration: none; }
-.news-title a:hover { color: #00b894; }
-.news-source { font-size: 13px; color: #b2bec3; }
-.news-source a { color: #0984e3; text-decoration: none; }
-.category-section { margin-top: 40px; }
-.category-title { border-left: 5px solid #00b894; padding-left: 15px; font-size: 22px; margin-bottom: 20px; }
-.product-section { background: linear-gradient(135deg, #fff5f5 0%, #ffe3e3 100%); padding: 25px; border-radius: 16px; margin-top: 30px; }
-.product-title { font-size: 20px; font-weight: 700; color: #c53030; margin: 0 0 20px 0; text-align: center; }
-.product-grid { display: grid; grid-template-columns: repeat(auto-fit, minmax(200px, 1fr)); gap: 15px; }
-.product-card { background: white; padding: 20px; border-radius: 12px; text-align: center; box-shadow: 0 2px 10px rgba(0,0,0,0.05); }
-.product-emoji { font-size: 40px; margin-bottom: 10px; }
-.product-name { font-size: 16px; font-weight: 600; color: #2d3436; }
-.product-desc { font-size: 13px; color: #636e72; margin: 5px 0; }
-.product-link { display: inline-block; background: #e53e3e; color: white; padding: 8px 16px; border-radius: 8px; text-decoration: none; font-size: 14px; margin-top: 10px; }
-.kbo-table { width: 100%; border-collapse: collapse; margin: 20px 0; }
-.kbo-table th { background: linear-gradient(135deg, #2d3436, #636e72); color: white; padding: 12px; }
-.kbo-table td { padding: 12px; border-bottom: 1px solid #eee; text-align: center; }
-.footer-notice { margin-top: 30px; padding: 20px; background: #f8f9fa; border-radius: 12px; font-size: 13px; color: #636e72; text-align: center; }
-.realtime-tag { background: #27ae60; color: white; padding: 3px 8px; border-radius: 4px; font-size: 11px; margin-left: 5px; }
-</style>
-`)
-
-	content.WriteString(fmt.Sprintf(`
-<div class="sports-container">
-<div class="sports-header">
-	<h1 style="margin: 0; font-size: 28px;">⚽ 실시간 스포츠 뉴스</h1>
-	<p style="margin: 10px 0 0 0; opacity: 0.9;">%s 업데이트 <span class="realtime-tag">실시간</span></p>
-</div>
-`, now.Format("2006년 01월 02일 15:04")))
-
-	// ===============================================
-	// 축구 경기 결과 (실시간)
-	// ===============================================
-	if len(footballMatches) > 0 {
-		content.WriteString(`
-<div class="match-section">
-	<h2 class="category-title">⚽ 축구 경기 현황</h2>
-`)
-		for _, match := range footballMatches {
-			liveTag := ""
-			if match.IsLive {
-				liveTag = `<span class="live-badge">🔴 LIVE</span>`
-			}
-			content.WriteString(fmt.Sprintf(`
-	<div class="match-card">
-		<div class="team">
-			<div class="team-name">%s</div>
-		</div>
-		<div class="score">%d - %d</div>
-		<div class="team">
-			<div class="team-name">%s</div>
-		</div>
-	</div>
-	<div style="text-align: center; margin-bottom: 15px;">
-		<span class="match-status">%s %s</span> %s
-	</div>
-`, match.HomeTeam, match.HomeScore, match.AwayScore, match.AwayTeam, match.Competition, match.Status, liveTag))
-		}
-		content.WriteString(`</div>`)
-	}
-
-	// ===============================================
-	// NBA 경기 결과 (실시간)
-	// ===============================================
-	if len(nbaGames) > 0 {
-		content.WriteString(`
-<div class="match-section">
-	<h2 class="category-title">🏀 NBA 경기 현황</h2>
-`)
-		for _, game := range nbaGames {
-			liveTag := ""
-			if game.IsLive {
-				liveTag = `<span class="live-badge">🔴 LIVE</span>`
-			}
-			content.WriteString(fmt.Sprintf(`
-	<div class="match-card">
-		<div class="team">
-			<div class="team-name">%s</div>
-		</div>
-		<div class="score">%d - %d</div>
-		<div class="team">
-			<div class="team-name">%s</div>
-		</div>
-	</div>
-	<div style="text-align: center; margin-bottom: 15px;">
-		<span class="match-status">%s</span> %s
-	</div>
-`, game.HomeTeam, game.HomeScore, game.AwayScore, game.AwayTeam, game.Status, liveTag))
-		}
-		content.WriteString(`</div>`)
+	view := SportsView{Updated: now.Format("2006년 01월 02일 15:04")}
+
+	// 축구/NBA 경기 결과 (실시간)
+	for _, match := range footballMatches {
+		view.Football = append(view.Football, SportsMatchView{
+			Home:      match.HomeTeam,
+			Away:      match.AwayTeam,
+			HomeScore: match.HomeScore,
+			AwayScore: match.AwayScore,
+			Status:    match.Competition + " " + match.Status,
+			Live:      match.IsLive,
+		})
+	}
+	for _, game := range nbaGames {
+		view.NBA = append(view.NBA, SportsMatchView{
+			Home:      game.HomeTeam,
+			Away:      game.AwayTeam,
+			HomeScore: game.HomeScore,
+			AwayScore: game.AwayScore,
+			Status:    game.Status,
+			Live:      game.IsLive,
+		})
 	}
 
-	// ===============================================
-	// 스포츠 뉴스
-	// ===============================================
+	// 종목별 뉴스 + 추천 상품
 	categories := map[string][]SportsNews{}
 	for _, n := range news {
 		categories[n.Category] = append(categories[n.Category], n)
@@ -588,101 +570,29 @@ func (s *SportsCollector) GenerateSportsPost(news []SportsNews) *Post {
 			continue
 		}
 
-		emoji := categoryEmojis[category]
-
-		content.WriteString(fmt.Sprintf(`
-<div class="category-section">
-<h2 class="category-title">%s %s 뉴스</h2>
-`, emoji, category))
-
-		for _, item := range items {
-			newsLink := item.Title
-			if item.Link != "" {
-				newsLink = fmt.Sprintf(`<a href="%s" target="_blank">%s</a>`, item.Link, item.Title)
-			}
-
-			sourceLink := item.Source
-			if item.SourceURL != "" {
-				sourceLink = fmt.Sprintf(`<a href="%s" target="_blank">%s 바로가기 →</a>`, item.SourceURL, item.Source)
-		}
-			content.WriteString(fmt.Sprintf(`
-<div class="news-card">
-	<h4 class="news-title">%s</h4>
-	<p class="news-source">📰 %s</p>
-</div>
-`, newsLink, sourceLink))
-	}
-
-		// 종목별 추천 상품
+		section := SportsSectionView{Emoji: categoryEmojis[category], Category: category, News: items}
 		if products, ok := sportsProducts[category]; ok && s.coupangID != "" {
-			content.WriteString(fmt.Sprintf(`
-<div class="product-section">
-	<h3 class="product-title">🛒 %s %s 추천 장비</h3>
-	<div class="product-grid">
-`, emoji, category))
-
 			for _, product := range products {
-				content.WriteString(fmt.Sprintf(`
-		<div class="product-card">
-			<div class="product-emoji">%s</div>
-			<div class="product-name">%s</div>
-			<div class="product-desc">%s</div>
-			<a href="%s" target="_blank" class="product-link">쿠팡에서 보기</a>
-		</div>
-`, product.Emoji, product.Name, product.Description, s.generateCoupangLink(product.SearchQuery)))
+				section.Products = append(section.Products, ProductLinkView{
+					Emoji:       product.Emoji,
+					Name:        product.Name,
+					Description: product.Description,
+					URL:         s.generateCoupangLink(product.SearchQuery),
+				})
 			}
-
-			content.WriteString(`
-	</div>
-</div>
-`)
 		}
-
-		content.WriteString(`</div>`) // category-section 끝
+		view.Sections = append(view.Sections, section)
 	}
 
 	// KBO 순위
-		content.WriteString(`
-<div class="category-section">
-<h2 class="category-title">⚾ 2024 KBO 최종 순위</h2>
-<div style="overflow-x: auto;">
-<table class="kbo-table">
-<tr>
-<th>순위</th><th>팀</th><th>승</th><th>패</th><th>무</th><th>승률</th>
-</tr>
-`)
-		for i, team := range s.GetKBOStandings(context.Background()) {
-		rankEmoji := ""
-		if i == 0 {
-			rankEmoji = "🥇 "
-		} else if i == 1 {
-			rankEmoji = "🥈 "
-		} else if i == 2 {
-			rankEmoji = "🥉 "
-		}
-			bgColor := "#fff"
-		if i < 3 {
-			bgColor = "#ffeaa7"
-			}
-			content.WriteString(fmt.Sprintf(`<tr style="background: %s;">
-<td style="font-weight: bold;">%s%d</td>
-<td style="font-weight: bold;">%s</td>
-<td>%d</td><td>%d</td><td>%d</td><td>%s</td>
-</tr>
-`, bgColor, rankEmoji, i+1, team.Name, team.Wins, team.Losses, team.Draws, team.Pct))
+	medals := []string{"🥇 ", "🥈 ", "🥉 "}
+	for i, team := range s.GetKBOStandings(ctx) {
+		row := KBORowView{KBOTeam: team, Position: i + 1, Top: i < 3}
+		if i < len(medals) {
+			row.Medal = medals[i]
 		}
-	content.WriteString(`</table></div></div>`)
-
-	// 푸터
-	content.WriteString(`
-<div class="footer-notice">
-	<p>⚡ 실시간 데이터 기반으로 자동 업데이트됩니다!</p>
-	<p style="margin-top: 10px; font-size: 12px; color: #888;">
-	⚠️ 본 포스팅은 쿠팡 파트너스 활동의 일환으로, 이에 따른 일정액의 수수료를 제공받습니다.
-	</p>
-</div>
-</div>
-`)
+		view.KBO = append(view.KBO, row)
+	}
 
 	// 동적 태그 생성
 	tags := []string{
@@ -712,8 +622,11 @@ func (s *SportsCollector) GenerateSportsPost(news []SportsNews) *Post {
 		}
 	}
 
-	// 상품 태그
-	for category := range categories {
+	// 상품 태그 (섹션 순서)
+	for _, category := range categoryOrder {
+		if _, ok := categories[category]; !ok {
+			continue
+		}
 		if products, ok := sportsProducts[category]; ok {
 			for _, p := range products[:2] {
 				tags = append(tags, p.Name)
@@ -723,11 +636,18 @@ func (s *SportsCollector) GenerateSportsPost(news []SportsNews) *Post {
 
 	tags = append(tags, "축구화", "야구글러브", "농구화", "스포츠장비추천", "프리미어리그", "NBA")
 
+	sourceIDs := make([]string, 0, len(news))
+	for _, n := range news {
+		sourceIDs = append(sourceIDs, n.Link)
+	}
+
 	return &Post{
-		Title:    title,
-		Content:  content.String(),
-		Category: "스포츠",
-		Tags:     tags,
+		Title:     title,
+		Template:  "sports",
+		Data:      view,
+		Category:  CategorySports,
+		Tags:      tags,
+		SourceIDs: sourceIDs,
 	}
 }
 
@@ -4,16 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"image/color"
 	"math"
 	"net/http"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // StockCollector 주식/코인 정보 수집기
 type StockCollector struct {
-	client *http.Client
+	client HTTPDoer
 }
 
 // StockData 주식 데이터
@@ -68,10 +71,31 @@ type CryptoRecommendation struct {
 
 func NewStockCollector() *StockCollector {
 	return &StockCollector{
-		client: &http.Client{Timeout: 30 * time.Second},
+		client: defaultHTTPClient(),
 	}
 }
 
+func init() {
+	Register(NewSource("crypto", SourceMeta{
+		Description:  "코인 시세 정보",
+		PostCategory: CategoryStock,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{255, 175, 0, 255}, // 골드
+			GradientEnd:   color.RGBA{255, 100, 0, 255}, // 오렌지
+			Emoji:         "BTC",
+			SubText:       "암호화폐 시세",
+		},
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewStockCollector()
+		c.client = env.Doer()
+		cryptos, err := c.GetTopCryptos(ctx, 10)
+		if err != nil {
+			return nil, fmt.Errorf("수집 실패: %w", err)
+		}
+		return c.GenerateCryptoPost(ctx, cryptos), nil
+	}))
+}
+
 // GetTopCryptos 상위 코인 정보 수집 (확장 버전)
 func (s *StockCollector) GetTopCryptos(ctx context.Context, limit int) ([]CryptoData, error) {
 	url := fmt.Sprintf(
@@ -137,7 +161,7 @@ func (s *StockCollector) GetTopCryptos(ctx context.Context, limit int) ([]Crypto
 			ATH:           c.ATH,
 			ATHChangePerc: c.ATHChangePercentage,
 			Sparkline:     sparkline,
-			UpdatedAt:     time.Now(),
+			UpdatedAt:     clock(),
 		})
 	}
 
@@ -155,7 +179,7 @@ func (s *StockCollector) GetMarketData(ctx context.Context) (*MarketData, error)
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return s.getSimulatedMarketData(), nil
+		return s.getSimulatedMarketData(ctx), nil
 	}
 	defer resp.Body.Close()
 
@@ -169,7 +193,7 @@ func (s *StockCollector) GetMarketData(ctx context.Context) (*MarketData, error)
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return s.getSimulatedMarketData(), nil
+		return s.getSimulatedMarketData(ctx), nil
 	}
 
 	return &MarketData{
@@ -182,7 +206,8 @@ func (s *StockCollector) GetMarketData(ctx context.Context) (*MarketData, error)
 }
 
 // getSimulatedMarketData 시뮬레이션 데이터
-func (s *StockCollector) getSimulatedMarketData() *MarketData {
+func (s *StockCollector) getSimulatedMarketData(ctx context.Context) *MarketData {
+	simulated(ctx, "crypto", "market")
 	return &MarketData{
 		TotalMarketCap:     3500000000000000, // 3500조
 		TotalVolume:        150000000000000,  // 150조
@@ -203,7 +228,7 @@ func (s *StockCollector) GetFearGreedIndex(ctx context.Context) (*FearGreedData,
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return s.getSimulatedFearGreed(), nil
+		return s.getSimulatedFearGreed(ctx), nil
 	}
 	defer resp.Body.Close()
 
@@ -215,11 +240,11 @@ func (s *StockCollector) GetFearGreedIndex(ctx context.Context) (*FearGreedData,
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return s.getSimulatedFearGreed(), nil
+		return s.getSimulatedFearGreed(ctx), nil
 	}
 
 	if len(result.Data) == 0 {
-		return s.getSimulatedFearGreed(), nil
+		return s.getSimulatedFearGreed(ctx), nil
 	}
 
 	value := 50
@@ -232,7 +257,8 @@ func (s *StockCollector) GetFearGreedIndex(ctx context.Context) (*FearGreedData,
 }
 
 // getSimulatedFearGreed 시뮬레이션 데이터
-func (s *StockCollector) getSimulatedFearGreed() *FearGreedData {
+func (s *StockCollector) getSimulatedFearGreed(ctx context.Context) *FearGreedData {
+	simulated(ctx, "crypto", "fear_greed")
 	return &FearGreedData{
 		Value:      45,
 		ValueClass: "Fear",
@@ -323,10 +349,68 @@ func (s *StockCollector) GetRecommendations(cryptos []CryptoData, fearGreed *Fea
 	return recommendations
 }
 
+// CryptoView 코인 시세 포스트 템플릿 데이터 (theme: crypto)
+type CryptoView struct {
+	Updated         string
+	Market          CryptoMarketView
+	FearGreed       FearGreedView
+	Recommendations []CryptoRecView
+	Coins           []CryptoRowView
+	UpCount         int
+	DownCount       int
+}
+
+// CryptoMarketView 시장 전체 지표 (금액은 억/조 단위 문자열)
+type CryptoMarketView struct {
+	TotalMarketCap string
+	TotalVolume    string
+	BTCDominance   float64
+	ETHDominance   float64
+	Change24h      Change
+}
+
+// FearGreedView 공포탐욕지수 표시 정보
+type FearGreedView struct {
+	Value int
+	Label string
+	Color string
+	Emoji string
+}
+
+// CryptoRecView 추천 종목 카드
+type CryptoRecView struct {
+	Name        string
+	Symbol      string
+	Reason      string
+	Signal      string // BUY, HOLD, WATCH
+	SignalClass string
+	Score       float64
+}
+
+// CryptoRowView 시가총액 표 한 줄
+type CryptoRowView struct {
+	Name      string
+	Symbol    string
+	Price     string
+	MarketCap string
+	Change1h  Change
+	Change24h Change
+	Change7d  Change
+	ATHChange Change
+}
+
+// Change 변동률(%) - 템플릿에서 .Class / .Color 로 상승/하락 스타일 지정
+type Change float64
+
+// Class 상승/하락 CSS 클래스
+func (c Change) Class() string { return getChangeClass(float64(c)) }
+
+// Color 상승/하락 색상
+func (c Change) Color() string { return getChangeColor(float64(c)) }
+
 // GenerateCryptoPost 코인 정보 포스트 생성 (풀 버전)
-func (s *StockCollector) GenerateCryptoPost(cryptos []CryptoData) *Post {
-	ctx := context.Background()
-	now := time.Now()
+func (s *StockCollector) GenerateCryptoPost(ctx context.Context, cryptos []CryptoData) *Post {
+	now := clock()
 
 	// 추가 데이터 수집
 	marketData, _ := s.GetMarketData(ctx)
@@ -368,201 +452,52 @@ func (s *StockCollector) GenerateCryptoPost(cryptos []CryptoData) *Post {
 	title := fmt.Sprintf("[%s] 코인 시세 분석 📊 공포탐욕 %d | BTC 도미넌스 %.1f%%",
 		now.Format("01/02"), fearGreed.Value, marketData.BTCDominance)
 
-	var content strings.Builder
-
-	// 스타일
-	content.WriteString(`
-<style>
-.crypto-container { max-width: 900px; margin: 0 auto; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', sans-serif; }
-.crypto-header { background: linear-gradient(135deg, #1a1a2e 0%, #16213e 100%); color: #fff; padding: 30px; border-radius: 16px; margin-bottom: 20px; }
-.crypto-header h1 { margin: 0 0 10px 0; font-size: 24px; }
-.market-stats { display: grid; grid-template-columns: repeat(auto-fit, minmax(150px, 1fr)); gap: 15px; margin-top: 20px; }
-.stat-box { background: rgba(255,255,255,0.1); padding: 15px; border-radius: 8px; text-align: center; }
-.stat-value { font-size: 24px; font-weight: 700; }
-.stat-label { font-size: 12px; opacity: 0.8; margin-top: 5px; }
-.fear-greed { text-align: center; padding: 20px; margin: 20px 0; background: #f5f5f5; border-radius: 12px; }
-.fear-greed .value { font-size: 64px; font-weight: 700; }
-.fear-greed .label { font-size: 18px; margin-top: 10px; }
-.fear-greed .bar { height: 10px; background: linear-gradient(to right, #e53935, #ff9800, #9e9e9e, #8bc34a, #4caf50); border-radius: 5px; margin-top: 15px; position: relative; }
-.fear-greed .pointer { position: absolute; top: -5px; width: 20px; height: 20px; background: #333; border-radius: 50%; transform: translateX(-50%); }
-.coin-table { width: 100%; border-collapse: collapse; margin: 20px 0; font-size: 14px; }
-.coin-table th { background: #1a1a2e; color: #fff; padding: 12px 8px; text-align: left; }
-.coin-table td { padding: 12px 8px; border-bottom: 1px solid #eee; }
-.coin-table tr:hover { background: #f9f9f9; }
-.coin-name { font-weight: 600; }
-.coin-symbol { color: #666; font-size: 12px; }
-.change-up { color: #4caf50; font-weight: 600; }
-.change-down { color: #e53935; font-weight: 600; }
-.sparkline { display: flex; align-items: end; height: 30px; gap: 1px; }
-.sparkline-bar { width: 4px; background: #4caf50; border-radius: 2px; }
-.recommendations { background: #fff3e0; padding: 25px; border-radius: 12px; margin: 20px 0; }
-.recommendations h2 { margin: 0 0 15px 0; color: #e65100; }
-.rec-card { background: #fff; padding: 15px; border-radius: 8px; margin-bottom: 10px; display: flex; justify-content: space-between; align-items: center; border-left: 4px solid #ff9800; }
-.rec-coin { font-weight: 600; font-size: 16px; }
-.rec-reason { font-size: 13px; color: #666; margin-top: 5px; }
-.rec-signal { padding: 5px 12px; border-radius: 4px; font-size: 12px; font-weight: 600; }
-.signal-buy { background: #4caf50; color: #fff; }
-.signal-hold { background: #ff9800; color: #fff; }
-.signal-watch { background: #9e9e9e; color: #fff; }
-.analysis-section { background: #f5f5f5; padding: 20px; border-radius: 12px; margin: 20px 0; }
-.analysis-section h3 { margin: 0 0 15px 0; }
-.analysis-grid { display: grid; grid-template-columns: repeat(2, 1fr); gap: 15px; }
-.analysis-item { background: #fff; padding: 15px; border-radius: 8px; }
-.analysis-item .label { font-size: 12px; color: #666; }
-.analysis-item .value { font-size: 18px; font-weight: 600; margin-top: 5px; }
-.footer-notice { margin-top: 20px; padding: 15px; background: #ffebee; border-radius: 8px; font-size: 12px; color: #c62828; }
-</style>
-`)
-
-	content.WriteString(`<div class="crypto-container">`)
-
-	// 헤더
-	content.WriteString(fmt.Sprintf(`
-<div class="crypto-header">
-	<h1>🪙 실시간 암호화폐 시세 분석</h1>
-	<p>%s 업데이트</p>
-	<div class="market-stats">
-		<div class="stat-box">
-			<div class="stat-value">%s</div>
-			<div class="stat-label">전체 시가총액</div>
-		</div>
-		<div class="stat-box">
-			<div class="stat-value">%s</div>
-			<div class="stat-label">24시간 거래량</div>
-		</div>
-		<div class="stat-box">
-			<div class="stat-value">%.1f%%</div>
-			<div class="stat-label">BTC 도미넌스</div>
-		</div>
-		<div class="stat-box">
-			<div class="stat-value" style="color: %s;">%+.1f%%</div>
-			<div class="stat-label">24시간 변동</div>
-		</div>
-	</div>
-</div>
-`, now.Format("2006년 01월 02일 15:04"),
-		formatNumber(marketData.TotalMarketCap),
-		formatNumber(marketData.TotalVolume),
-		marketData.BTCDominance,
-		getChangeColor(marketData.MarketCapChange24h),
-		marketData.MarketCapChange24h))
-
-	// 공포탐욕지수
-	content.WriteString(fmt.Sprintf(`
-<div class="fear-greed">
-	<div class="value" style="color: %s;">%s %d</div>
-	<div class="label">공포 & 탐욕 지수: <strong>%s</strong></div>
-	<div class="bar">
-		<div class="pointer" style="left: %d%%;"></div>
-	</div>
-	<p style="font-size: 12px; color: #666; margin-top: 15px;">0 = 극도의 공포 | 100 = 극도의 탐욕</p>
-</div>
-`, fgColor, fgEmoji, fearGreed.Value, getFearGreedKorean(fearGreed.ValueClass), fearGreed.Value))
-
-	// 추천 종목
-	if len(recommendations) > 0 {
-		content.WriteString(`
-<div class="recommendations">
-	<h2>🎯 AI 추천 종목 TOP 5</h2>
-`)
-		for _, rec := range recommendations {
-			signalClass := "signal-watch"
-			if rec.SignalType == "BUY" {
-				signalClass = "signal-buy"
-			} else if rec.SignalType == "HOLD" {
-				signalClass = "signal-hold"
-			}
-
-			content.WriteString(fmt.Sprintf(`
-	<div class="rec-card">
-		<div>
-			<div class="rec-coin">%s (%s)</div>
-			<div class="rec-reason">%s</div>
-		</div>
-		<div>
-			<span class="rec-signal %s">%s</span>
-			<div style="font-size: 12px; color: #666; margin-top: 5px;">점수: %.0f</div>
-		</div>
-	</div>
-`, rec.Coin.Name, rec.Coin.Symbol, rec.Reason, signalClass, rec.SignalType, rec.Score))
+	view := CryptoView{
+		Updated: now.Format("2006년 01월 02일 15:04"),
+		Market: CryptoMarketView{
+			TotalMarketCap: formatNumber(marketData.TotalMarketCap),
+			TotalVolume:    formatNumber(marketData.TotalVolume),
+			BTCDominance:   marketData.BTCDominance,
+			ETHDominance:   marketData.ETHDominance,
+			Change24h:      Change(marketData.MarketCapChange24h),
+		},
+		FearGreed: FearGreedView{
+			Value: fearGreed.Value,
+			Label: getFearGreedKorean(fearGreed.ValueClass),
+			Color: fgColor,
+			Emoji: fgEmoji,
+		},
+		UpCount:   upCount,
+		DownCount: len(cryptos) - upCount,
+	}
+	for _, rec := range recommendations {
+		signalClass := "signal-watch"
+		if rec.SignalType == "BUY" {
+			signalClass = "signal-buy"
+		} else if rec.SignalType == "HOLD" {
+			signalClass = "signal-hold"
 		}
-		content.WriteString(`</div>`)
-	}
-
-	// 코인 테이블
-	content.WriteString(`
-<h2>📊 시가총액 TOP 10</h2>
-<table class="coin-table">
-<tr>
-	<th>#</th>
-	<th>코인</th>
-	<th>현재가</th>
-	<th>1시간</th>
-	<th>24시간</th>
-	<th>7일</th>
-	<th>시가총액</th>
-	<th>ATH 대비</th>
-</tr>
-`)
-
-	for i, c := range cryptos {
-		content.WriteString(fmt.Sprintf(`
-<tr>
-	<td>%d</td>
-	<td><span class="coin-name">%s</span> <span class="coin-symbol">%s</span></td>
-	<td>₩%s</td>
-	<td class="%s">%+.1f%%</td>
-	<td class="%s">%+.1f%%</td>
-	<td class="%s">%+.1f%%</td>
-	<td>%s</td>
-	<td class="%s">%+.1f%%</td>
-</tr>
-`, i+1, c.Name, c.Symbol,
-			formatNumber(c.Price),
-			getChangeClass(c.Change1h), c.Change1h,
-			getChangeClass(c.Change24h), c.Change24h,
-			getChangeClass(c.Change7d), c.Change7d,
-			formatNumber(c.MarketCap),
-			getChangeClass(c.ATHChangePerc), c.ATHChangePerc))
-	}
-
-	content.WriteString(`</table>`)
-
-	// 시장 분석
-	content.WriteString(fmt.Sprintf(`
-<div class="analysis-section">
-	<h3>📈 시장 분석 요약</h3>
-	<div class="analysis-grid">
-		<div class="analysis-item">
-			<div class="label">상승 코인</div>
-			<div class="value" style="color: #4caf50;">%d개</div>
-		</div>
-		<div class="analysis-item">
-			<div class="label">하락 코인</div>
-			<div class="value" style="color: #e53935;">%d개</div>
-		</div>
-		<div class="analysis-item">
-			<div class="label">시장 심리</div>
-			<div class="value">%s</div>
-		</div>
-		<div class="analysis-item">
-			<div class="label">ETH 도미넌스</div>
-			<div class="value">%.1f%%</div>
-		</div>
-	</div>
-</div>
-`, upCount, len(cryptos)-upCount, getFearGreedKorean(fearGreed.ValueClass), marketData.ETHDominance))
-
-	// 푸터
-	content.WriteString(`
-<div class="footer-notice">
-	<p>⚠️ <strong>투자 주의사항</strong></p>
-	<p>본 분석은 참고용이며 투자 권유가 아닙니다. 암호화폐 투자는 원금 손실 위험이 있으며, 모든 투자 결정과 책임은 본인에게 있습니다.</p>
-	<p>데이터 출처: CoinGecko, Alternative.me</p>
-</div>
-`)
-
-	content.WriteString(`</div>`)
+		view.Recommendations = append(view.Recommendations, CryptoRecView{
+			Name:        rec.Coin.Name,
+			Symbol:      rec.Coin.Symbol,
+			Reason:      rec.Reason,
+			Signal:      rec.SignalType,
+			SignalClass: signalClass,
+			Score:       rec.Score,
+		})
+	}
+	for _, c := range cryptos {
+		view.Coins = append(view.Coins, CryptoRowView{
+			Name:      c.Name,
+			Symbol:    c.Symbol,
+			Price:     formatNumber(c.Price),
+			MarketCap: formatNumber(c.MarketCap),
+			Change1h:  Change(c.Change1h),
+			Change24h: Change(c.Change24h),
+			Change7d:  Change(c.Change7d),
+			ATHChange: Change(c.ATHChangePerc),
+		})
+	}
 
 	// 동적 태그 생성 (실제 코인 데이터 기반)
 	tags := []string{
@@ -607,8 +542,9 @@ func (s *StockCollector) GenerateCryptoPost(cryptos []CryptoData) *Post {
 
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: "주식/코인",
+		Template: "crypto",
+		Data:     view,
+		Category: CategoryStock,
 		Tags:     tags,
 	}
 }
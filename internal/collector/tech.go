@@ -4,14 +4,18 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"image/color"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // TechCollector IT/테크 뉴스 수집기
 type TechCollector struct {
-	client *http.Client
+	client HTTPDoer
 }
 
 // TechNews 테크 뉴스
@@ -41,10 +45,35 @@ type RSSItem struct {
 
 func NewTechCollector() *TechCollector {
 	return &TechCollector{
-		client: &http.Client{Timeout: 30 * time.Second},
+		client: defaultHTTPClient(),
 	}
 }
 
+func init() {
+	Register(NewSource("tech", SourceMeta{
+		Description:  "IT/테크 뉴스",
+		PostCategory: CategoryTech,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{0, 150, 255, 255},  // 블루
+			GradientEnd:   color.RGBA{100, 50, 200, 255}, // 퍼플
+			Emoji:         "TECH",
+			SubText:       "IT/테크 뉴스",
+		},
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewTechCollector()
+		c.client = env.Doer()
+		news, err := c.GetTechNews(ctx, 10+len(env.Exclude))
+		if err != nil {
+			return nil, fmt.Errorf("수집 실패: %w", err)
+		}
+		news = filterExcluded(env, news, func(n TechNews) string { return n.Link })
+		if len(news) > 10 {
+			news = news[:10]
+		}
+		return c.GenerateTechPost(news), nil
+	}))
+}
+
 // 테크 뉴스 RSS 피드 목록
 var techRSSFeeds = map[string]string{
 	"지디넷코리아": "https://www.zdnet.co.kr/rss/newsall.xml",
@@ -57,8 +86,15 @@ var techRSSFeeds = map[string]string{
 func (t *TechCollector) GetTechNews(ctx context.Context, limit int) ([]TechNews, error) {
 	var allNews []TechNews
 
-	for source, feedURL := range techRSSFeeds {
-		news, err := t.fetchRSS(ctx, feedURL, source)
+	// 피드 순서를 고정해야 같은 응답에서 같은 글이 나옴 (녹화 재생)
+	sources := make([]string, 0, len(techRSSFeeds))
+	for source := range techRSSFeeds {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		news, err := t.fetchRSS(ctx, techRSSFeeds[source], source)
 		if err != nil {
 			continue // 에러 무시하고 다음 피드로
 		}
@@ -66,6 +102,9 @@ func (t *TechCollector) GetTechNews(ctx context.Context, limit int) ([]TechNews,
 	}
 
 	// 최신순 정렬 후 limit 적용
+	sort.SliceStable(allNews, func(i, j int) bool {
+		return allNews[i].PubDate.After(allNews[j].PubDate)
+	})
 	if len(allNews) > limit {
 		allNews = allNews[:limit]
 	}
@@ -130,38 +169,32 @@ func stripHTML(s string) string {
 	return strings.TrimSpace(result.String())
 }
 
+// TechView 테크 뉴스 포스트 템플릿 데이터 (theme: tech)
+type TechView struct {
+	Updated string
+	News    []TechNews
+}
+
 // GenerateTechPost 테크 뉴스 포스트 생성
 func (t *TechCollector) GenerateTechPost(news []TechNews) *Post {
-	now := time.Now()
+	now := clock()
 	title := fmt.Sprintf("[%s] IT/테크 뉴스 브리핑 💻", now.Format("01/02"))
 
-	var content strings.Builder
-	content.WriteString(`<h2>💻 오늘의 IT/테크 뉴스</h2>
-<p>업데이트: ` + now.Format("2006년 01월 02일 15:04") + `</p>
-`)
-
-	for i, n := range news {
-		content.WriteString(fmt.Sprintf(`
-<div style="border-left: 4px solid #007bff; padding: 10px 15px; margin: 15px 0; background: #f8f9fa;">
-<h3>%d. %s</h3>
-<p>%s</p>
-<p style="color: #666; font-size: 0.9em;">출처: %s | <a href="%s" target="_blank">원문 보기</a></p>
-</div>
-`, i+1, n.Title, truncate(n.Description, 200), n.Source, n.Link))
+	sourceIDs := make([]string, 0, len(news))
+	for _, n := range news {
+		sourceIDs = append(sourceIDs, n.Link)
 	}
 
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: CategoryTech,
-		Tags:     []string{"IT뉴스", "테크", "기술", "AI", "스마트폰"},
-	}
-}
-
-func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
+		Template: "tech",
+		Data: TechView{
+			Updated: now.Format("2006년 01월 02일 15:04"),
+			News:    news,
+		},
+		Category:  CategoryTech,
+		Tags:      []string{"IT뉴스", "테크", "기술", "AI", "스마트폰"},
+		SourceIDs: sourceIDs,
 	}
-	return s[:maxLen] + "..."
 }
 
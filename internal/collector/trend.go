@@ -4,16 +4,21 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"image/color"
 	"io"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/Song-wh/tistory-bot/internal/thumbnail"
 )
 
 // TrendCollector 트렌드/실검 수집기
 type TrendCollector struct {
-	client *http.Client
+	client HTTPDoer
 }
 
 // Trend 트렌드 정보
@@ -50,10 +55,36 @@ type GoogleNewsItem struct {
 
 func NewTrendCollector() *TrendCollector {
 	return &TrendCollector{
-		client: &http.Client{Timeout: 30 * time.Second},
+		client: defaultHTTPClient(),
 	}
 }
 
+func init() {
+	Register(NewSource("trend", SourceMeta{
+		Description:  "트렌드/실검",
+		PostCategory: CategoryTrend,
+		Thumbnail: &thumbnail.CategoryStyle{
+			GradientStart: color.RGBA{255, 65, 108, 255}, // 핑크
+			GradientEnd:   color.RGBA{255, 75, 43, 255},  // 레드오렌지
+			Emoji:         "HOT",
+			SubText:       "실시간 트렌드",
+		},
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewTrendCollector()
+		c.client = env.Doer()
+		// 실제 API 연동: 구글 트렌드 RSS + 네이버 뉴스 RSS
+		trends, err := c.GetAllTrends(ctx)
+		if err != nil {
+			logging.From(ctx).Warn("⚠️ 실시간 트렌드 수집 실패, 백업 데이터 사용", "err", err)
+		}
+		trends = filterExcluded(env, trends, func(t Trend) string { return t.Keyword })
+		if len(trends) > 15 {
+			trends = trends[:15] // 최대 15개
+		}
+		return c.GenerateTrendPost(trends), nil
+	}))
+}
+
 // GetGoogleTrends 구글 트렌드 수집 (실제 RSS 연동)
 func (t *TrendCollector) GetGoogleTrends(ctx context.Context, limit int) ([]Trend, error) {
 	// Google Trends RSS (한국)
@@ -108,7 +139,7 @@ func (t *TrendCollector) GetGoogleTrends(ctx context.Context, limit int) ([]Tren
 			Keyword:   keyword,
 			Link:      newsLink,
 			Source:    "Google Trends",
-			UpdatedAt: time.Now(),
+			UpdatedAt: clock(),
 		})
 		rank++
 	}
@@ -159,7 +190,7 @@ func (t *TrendCollector) GetNaverNewsRSS(ctx context.Context, limit int) ([]Tren
 				Keyword:   keyword,
 				Link:      item.Link,
 				Source:    "네이버 뉴스",
-				UpdatedAt: time.Now(),
+				UpdatedAt: clock(),
 			})
 			rank++
 		}
@@ -204,7 +235,7 @@ func (t *TrendCollector) GetAllTrends(ctx context.Context) ([]Trend, error) {
 
 // getBackupTrends API 실패 시 백업 트렌드
 func (t *TrendCollector) getBackupTrends() []Trend {
-	now := time.Now()
+	now := clock()
 	keywords := []string{
 		"크리스마스", "연말정산", "송년회", "새해", "부동산",
 		"날씨", "코로나", "주식", "비트코인", "환율",
@@ -222,96 +253,42 @@ func (t *TrendCollector) getBackupTrends() []Trend {
 	return trends
 }
 
-// GenerateTrendPost 트렌드 포스트 생성
-func (t *TrendCollector) GenerateTrendPost(trends []Trend) *Post {
-	now := time.Now()
-	title := fmt.Sprintf("[%s] 실시간 인기 검색어 TOP %d 🔥", now.Format("01/02 15:00"), len(trends))
-
-	var content strings.Builder
-
-	content.WriteString(`
-<style>
-.trend-container { max-width: 800px; margin: 0 auto; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', sans-serif; }
-.trend-header { background: linear-gradient(135deg, #667eea 0%, #764ba2 100%); padding: 30px; border-radius: 20px; color: white; text-align: center; margin-bottom: 25px; }
-.trend-header h1 { margin: 0; font-size: 26px; }
-.trend-header .update-time { opacity: 0.9; margin-top: 8px; font-size: 14px; }
-.trend-source { display: flex; gap: 10px; justify-content: center; margin-top: 15px; }
-.trend-source span { background: rgba(255,255,255,0.2); padding: 5px 12px; border-radius: 20px; font-size: 12px; }
-.trend-list { background: #f8f9fa; border-radius: 16px; padding: 20px; }
-.trend-item { display: flex; align-items: center; padding: 15px; border-bottom: 1px solid #e9ecef; transition: background 0.2s; }
-.trend-item:hover { background: #fff; }
-.trend-item:last-child { border-bottom: none; }
-.trend-rank { width: 40px; height: 40px; border-radius: 50%; display: flex; align-items: center; justify-content: center; font-weight: bold; margin-right: 15px; }
-.rank-1 { background: linear-gradient(135deg, #FFD700, #FFA500); color: white; font-size: 18px; }
-.rank-2 { background: linear-gradient(135deg, #C0C0C0, #A0A0A0); color: white; font-size: 18px; }
-.rank-3 { background: linear-gradient(135deg, #CD7F32, #B87333); color: white; font-size: 18px; }
-.rank-default { background: #e9ecef; color: #495057; }
-.trend-keyword { flex: 1; font-size: 16px; font-weight: 500; color: #2d3436; }
-.trend-keyword a { color: #2d3436; text-decoration: none; }
-.trend-keyword a:hover { color: #667eea; }
-.trend-source-tag { font-size: 11px; padding: 4px 8px; border-radius: 4px; background: #e3f2fd; color: #1976d2; }
-.google-tag { background: #fce4ec; color: #c2185b; }
-.naver-tag { background: #e8f5e9; color: #388e3c; }
-.trend-footer { margin-top: 25px; padding: 20px; background: #fff3cd; border-radius: 12px; text-align: center; }
-</style>
-`)
-
-	content.WriteString(fmt.Sprintf(`
-<div class="trend-container">
-<div class="trend-header">
-	<h1>🔥 실시간 인기 검색어</h1>
-	<p class="update-time">📅 %s 업데이트</p>
-	<div class="trend-source">
-		<span>📊 Google Trends</span>
-		<span>📰 네이버 뉴스</span>
-	</div>
-</div>
-
-<div class="trend-list">
-`, now.Format("2006년 01월 02일 15:04")))
-
-	for _, trend := range trends {
-		rankClass := "rank-default"
-		if trend.Rank == 1 {
-			rankClass = "rank-1"
-		} else if trend.Rank == 2 {
-			rankClass = "rank-2"
-		} else if trend.Rank == 3 {
-			rankClass = "rank-3"
-		}
-
-		sourceClass := "naver-tag"
-		if trend.Source == "Google Trends" {
-			sourceClass = "google-tag"
-		}
+// TrendView 인기 검색어 포스트 템플릿 데이터 (theme: trend)
+type TrendView struct {
+	Updated string
+	Trends  []Trend
+}
 
-		keywordLink := trend.Keyword
-		if trend.Link != "" {
-			keywordLink = fmt.Sprintf(`<a href="%s" target="_blank">%s</a>`, trend.Link, trend.Keyword)
-		} else {
-			// 구글 검색 링크 생성
-			searchURL := fmt.Sprintf("https://www.google.com/search?q=%s", trend.Keyword)
-			keywordLink = fmt.Sprintf(`<a href="%s" target="_blank">%s</a>`, searchURL, trend.Keyword)
-		}
+// RankClass 순위 배지 CSS 클래스 (1~3위 강조)
+func (t Trend) RankClass() string {
+	switch t.Rank {
+	case 1, 2, 3:
+		return fmt.Sprintf("rank-%d", t.Rank)
+	default:
+		return "rank-default"
+	}
+}
 
-		content.WriteString(fmt.Sprintf(`
-<div class="trend-item">
-	<div class="trend-rank %s">%d</div>
-	<div class="trend-keyword">%s</div>
-	<span class="trend-source-tag %s">%s</span>
-</div>
-`, rankClass, trend.Rank, keywordLink, sourceClass, trend.Source))
+// SourceClass 출처 태그 CSS 클래스
+func (t Trend) SourceClass() string {
+	if t.Source == "Google Trends" {
+		return "google-tag"
 	}
+	return "naver-tag"
+}
 
-	content.WriteString(`
-</div>
+// URL 키워드 링크 (링크가 없으면 구글 검색)
+func (t Trend) URL() string {
+	if t.Link != "" {
+		return t.Link
+	}
+	return "https://www.google.com/search?q=" + url.QueryEscape(t.Keyword)
+}
 
-<div class="trend-footer">
-	<p>💡 <strong>실시간 데이터</strong>를 기반으로 수집된 인기 검색어입니다.</p>
-	<p style="font-size: 13px; color: #856404; margin-top: 8px;">각 키워드를 클릭하면 관련 정보를 확인할 수 있습니다.</p>
-</div>
-</div>
-`)
+// GenerateTrendPost 트렌드 포스트 생성
+func (t *TrendCollector) GenerateTrendPost(trends []Trend) *Post {
+	now := clock()
+	title := fmt.Sprintf("[%s] 실시간 인기 검색어 TOP %d 🔥", now.Format("01/02 15:00"), len(trends))
 
 	// 동적 태그 생성 (실제 검색어 기반)
 	tags := []string{
@@ -330,11 +307,21 @@ func (t *TrendCollector) GenerateTrendPost(trends []Trend) *Post {
 		}
 	}
 
+	sourceIDs := make([]string, 0, len(trends))
+	for _, trend := range trends {
+		sourceIDs = append(sourceIDs, trend.Keyword)
+	}
+
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: CategoryTrend,
-		Tags:     tags,
+		Template: "trend",
+		Data: TrendView{
+			Updated: now.Format("2006년 01월 02일 15:04"),
+			Trends:  trends,
+		},
+		Category:  CategoryTrend,
+		Tags:      tags,
+		SourceIDs: sourceIDs,
 	}
 }
 
@@ -4,12 +4,24 @@ import "time"
 
 // Post 블로그 포스트
 type Post struct {
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	Category  string    `json:"category"`
-	Tags      []string  `json:"tags"`
-	Thumbnail string    `json:"thumbnail"`
-	CreatedAt time.Time `json:"created_at"`
+	Title     string      `json:"title"`
+	Content   string      `json:"content"`
+	Template  string      `json:"template,omitempty"` // 본문 테마 템플릿 (설정 시 Data로 Content 렌더링)
+	Data      interface{} `json:"-"`                  // 템플릿 데이터
+	Category  string      `json:"category"`
+	Tags      []string    `json:"tags"`
+	Thumbnail string      `json:"thumbnail"`
+	SourceIDs []string    `json:"source_ids"` // 중복 검사용 소스 항목 ID (뉴스 링크, 상품 ID 등)
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// ProductLinkView 템플릿용 추천 상품 링크 (게임/영화/스포츠 등 쿠팡 검색 링크)
+type ProductLinkView struct {
+	Emoji       string
+	Name        string
+	Description string
+	Category    string
+	URL         string
 }
 
 // Category 카테고리 정보
@@ -28,4 +40,9 @@ const (
 	CategoryTrend   = "트렌드/실검"
 	CategoryCoupang = "쿠팡/특가"
 	CategoryGolf    = "골프/날씨"
+	CategoryLotto   = "로또/복권"
+	CategoryFortune = "운세/점술"
+	CategorySports  = "스포츠"
+	CategoryError   = "에러/해결"
+	CategoryWeather = "날씨/생활"
 )
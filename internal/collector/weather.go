@@ -6,12 +6,11 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
 )
 
 // WeatherCollector 날씨 정보 수집기
 type WeatherCollector struct {
-	client *http.Client
+	client HTTPDoer
 }
 
 // Weather 날씨 정보
@@ -27,10 +26,25 @@ type Weather struct {
 
 func NewWeatherCollector() *WeatherCollector {
 	return &WeatherCollector{
-		client: &http.Client{Timeout: 30 * time.Second},
+		client: defaultHTTPClient(),
 	}
 }
 
+func init() {
+	Register(NewSource("weather", SourceMeta{
+		Description:  "주요 도시 날씨",
+		PostCategory: CategoryWeather,
+	}, func(ctx context.Context, env *Env) (*Post, error) {
+		c := NewWeatherCollector()
+		c.client = env.Doer()
+		weathers, err := c.GetWeather(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("수집 실패: %w", err)
+		}
+		return c.GenerateWeatherPost(weathers), nil
+	}))
+}
+
 // GetWeather 주요 도시 날씨 정보 조회 (wttr.in 무료 API 사용)
 func (w *WeatherCollector) GetWeather(ctx context.Context) ([]Weather, error) {
 	cities := []string{"Seoul", "Busan", "Incheon", "Daegu", "Daejeon", "Gwangju", "Jeju"}
@@ -82,54 +96,54 @@ func (w *WeatherCollector) GetWeather(ctx context.Context) ([]Weather, error) {
 	return weathers, nil
 }
 
+// WeatherView 날씨 포스트 템플릿 데이터 (theme: weather)
+type WeatherView struct {
+	Updated  string
+	Cities   []WeatherCityView
+	Clothing *Clothing // 서울 기온 기준 옷차림 (날씨 없으면 nil)
+}
+
+// WeatherCityView 도시별 날씨
+type WeatherCityView struct {
+	Emoji       string
+	City        string
+	Temperature float64
+	Humidity    int
+}
+
+// Clothing 기온별 옷차림 추천
+type Clothing struct {
+	Label      string // 예: 🔥 무더위 (28°C 이상)
+	Items      string
+	Background string
+	Light      bool // 밝은 배경 (글자색 기본값 사용)
+}
+
 // GenerateWeatherPost 날씨 포스트 생성
 func (w *WeatherCollector) GenerateWeatherPost(weathers []Weather) *Post {
-	now := time.Now()
+	now := clock()
 	title := fmt.Sprintf("🌤️ 오늘의 날씨 [%s] 전국 주요 도시", now.Format("01/02"))
 
-	var content strings.Builder
-	content.WriteString(fmt.Sprintf(`<h2>🌤️ 오늘의 날씨</h2>
-<p>업데이트: %s</p>
-
-<div style="background: linear-gradient(135deg, #74b9ff 0%%, #0984e3 100%%); padding: 20px; border-radius: 15px; color: white; margin: 20px 0;">
-<h3 style="color: white; margin-bottom: 20px;">📍 전국 주요 도시 날씨</h3>
-`, now.Format("2006년 01월 02일 15:04")))
-
+	view := WeatherView{Updated: now.Format("2006년 01월 02일 15:04")}
 	for _, weather := range weathers {
-		emoji := getWeatherEmoji(weather.Description)
-		content.WriteString(fmt.Sprintf(`
-<div style="background: rgba(255,255,255,0.2); padding: 15px; border-radius: 10px; margin-bottom: 10px; display: flex; justify-content: space-between; align-items: center;">
-<span style="font-size: 1.2em;">%s %s</span>
-<span style="font-size: 1.5em; font-weight: bold;">%s %.0f°C</span>
-<span>습도 %d%%</span>
-</div>
-`, emoji, weather.City, getWeatherEmoji(weather.Description), weather.Temperature, weather.Humidity))
+		view.Cities = append(view.Cities, WeatherCityView{
+			Emoji:       getWeatherEmoji(weather.Description),
+			City:        weather.City,
+			Temperature: weather.Temperature,
+			Humidity:    weather.Humidity,
+		})
 	}
 
-	content.WriteString(`</div>
-
-<h3>👔 오늘의 옷차림 추천</h3>
-`)
-
 	// 서울 기온 기준 옷차림 추천
 	if len(weathers) > 0 {
-		temp := weathers[0].Temperature
-		content.WriteString(getClothingRecommendation(temp))
+		view.Clothing = getClothingRecommendation(weathers[0].Temperature)
 	}
 
-	content.WriteString(`
-<h3>☔ 우산 체크</h3>
-<p>외출 전 기상청 레이더 영상을 확인하세요!</p>
-
-<p style="color: #888; font-size: 0.9em; margin-top: 30px;">
-※ 날씨 정보는 참고용이며, 정확한 정보는 기상청에서 확인하세요.
-</p>
-`)
-
 	return &Post{
 		Title:    title,
-		Content:  content.String(),
-		Category: "날씨/생활",
+		Template: "weather",
+		Data:     view,
+		Category: CategoryWeather,
 		Tags:     []string{"오늘날씨", "전국날씨", "날씨", "기온", "옷차림추천", now.Format("01월02일날씨")},
 	}
 }
@@ -168,38 +182,20 @@ func getWeatherEmoji(desc string) string {
 	}
 }
 
-func getClothingRecommendation(temp float64) string {
+func getClothingRecommendation(temp float64) *Clothing {
 	switch {
 	case temp >= 28:
-		return `<div style="background: #ff7675; padding: 15px; border-radius: 10px; color: white;">
-<p><strong>🔥 무더위 (28°C 이상)</strong></p>
-<p>민소매, 반팔, 반바지, 원피스</p>
-</div>`
+		return &Clothing{Label: "🔥 무더위 (28°C 이상)", Items: "민소매, 반팔, 반바지, 원피스", Background: "#ff7675"}
 	case temp >= 23:
-		return `<div style="background: #fdcb6e; padding: 15px; border-radius: 10px;">
-<p><strong>☀️ 더움 (23~27°C)</strong></p>
-<p>반팔, 얇은 셔츠, 면바지</p>
-</div>`
+		return &Clothing{Label: "☀️ 더움 (23~27°C)", Items: "반팔, 얇은 셔츠, 면바지", Background: "#fdcb6e", Light: true}
 	case temp >= 17:
-		return `<div style="background: #74b9ff; padding: 15px; border-radius: 10px; color: white;">
-<p><strong>🌤️ 따뜻함 (17~22°C)</strong></p>
-<p>얇은 가디건, 긴팔, 면바지</p>
-</div>`
+		return &Clothing{Label: "🌤️ 따뜻함 (17~22°C)", Items: "얇은 가디건, 긴팔, 면바지", Background: "#74b9ff"}
 	case temp >= 12:
-		return `<div style="background: #a29bfe; padding: 15px; border-radius: 10px; color: white;">
-<p><strong>🍂 선선함 (12~16°C)</strong></p>
-<p>자켓, 가디건, 니트</p>
-</div>`
+		return &Clothing{Label: "🍂 선선함 (12~16°C)", Items: "자켓, 가디건, 니트", Background: "#a29bfe"}
 	case temp >= 6:
-		return `<div style="background: #636e72; padding: 15px; border-radius: 10px; color: white;">
-<p><strong>🧥 쌀쌀함 (6~11°C)</strong></p>
-<p>코트, 점퍼, 니트, 스타킹</p>
-</div>`
+		return &Clothing{Label: "🧥 쌀쌀함 (6~11°C)", Items: "코트, 점퍼, 니트, 스타킹", Background: "#636e72"}
 	default:
-		return `<div style="background: #2d3436; padding: 15px; border-radius: 10px; color: white;">
-<p><strong>❄️ 추움 (5°C 이하)</strong></p>
-<p>패딩, 두꺼운 코트, 목도리, 장갑</p>
-</div>`
+		return &Clothing{Label: "❄️ 추움 (5°C 이하)", Items: "패딩, 두꺼운 코트, 목도리, 장갑", Background: "#2d3436"}
 	}
 }
 
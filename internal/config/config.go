@@ -1,8 +1,13 @@
 package config
 
 import (
+	"bytes"
+	"fmt"
 	"os"
+	"sort"
+	"time"
 
+	"github.com/Song-wh/tistory-bot/internal/secrets"
 	"gopkg.in/yaml.v3"
 )
 
@@ -19,10 +24,39 @@ type Config struct {
 	Coupang      CoupangConfig       `yaml:"coupang"`
 	FootballData *FootballDataConfig `yaml:"football_data"` // 스포츠 API (선택)
 	Thumbnail    *ThumbnailConfig    `yaml:"thumbnail"`     // 썸네일 설정 (선택)
+	Images       *ImagesConfig       `yaml:"images"`        // 본문 이미지 업로드 (선택)
+	History      *HistoryConfig      `yaml:"history"`       // 발행 이력/중복 방지 (선택)
+	Queue        *QueueConfig        `yaml:"queue"`         // 스케줄 작업 큐 (생략 시 기본값)
+	Runs         *RunsConfig         `yaml:"runs"`          // 실행 기록 (생략 시 ./runs_data)
 	Categories   map[string]string   `yaml:"categories"`
 	Schedule     ScheduleConfig      `yaml:"schedule"`
+	ThemesDir    string              `yaml:"themes_dir"` // 본문 테마 디렉토리 ({themes_dir}/{theme}/*.html)
+	Secrets      *SecretsConfig      `yaml:"secrets"`    // 비밀 저장소 (생략 시 ./secrets.vault)
+	Log          *LogConfig          `yaml:"log"`        // 로그 레벨/형식/파일 (생략 시 콘솔 text/info)
+	Notify       *NotifyConfig       `yaml:"notify"`     // 실행 알림 (webhook/slack/telegram/email, 선택)
+
+	root   *yaml.Node        // 파싱된 YAML 트리 (검증 메시지 줄 번호용)
+	legacy bool              // accounts 없이 최상위 설정으로 만든 단일 계정
+	refs   map[string]string // 설정 경로 → 원래 비밀 참조 (${ENV}, file:, vault:)
+}
+
+// LogConfig 로그 설정
+type LogConfig struct {
+	Level      string `yaml:"level"`       // debug | info | warn | error (기본 info)
+	Format     string `yaml:"format"`      // 콘솔 형식: text | json (기본 text)
+	File       string `yaml:"file"`        // 로그 파일 (JSON 줄, 빈 값 = 파일 기록 안 함)
+	MaxSizeMB  int    `yaml:"max_size_mb"` // 이 크기를 넘으면 회전 (기본 10)
+	MaxBackups int    `yaml:"max_backups"` // 남길 회전 파일 수 (기본 5)
+}
+
+// SecretsConfig 비밀 저장소 설정
+type SecretsConfig struct {
+	Vault string `yaml:"vault"` // 암호화된 비밀 저장소 파일 (vault:이름 참조용)
 }
 
+// DefaultVaultPath secrets.vault 미지정 시 비밀 저장소 경로
+const DefaultVaultPath = "./secrets.vault"
+
 // FootballDataConfig 스포츠 API 설정
 type FootballDataConfig struct {
 	APIKey string `yaml:"api_key"`
@@ -32,6 +66,100 @@ type FootballDataConfig struct {
 type ThumbnailConfig struct {
 	Enabled   bool   `yaml:"enabled"`
 	OutputDir string `yaml:"output_dir"`
+	InBody    bool   `yaml:"in_body"` // 대표이미지 외에 본문 맨 앞에도 첨부 (기본 false)
+}
+
+// ImagesConfig 본문 이미지 업로드 설정 (외부/로컬 이미지를 티스토리 첨부로 올리고 주소 교체)
+type ImagesConfig struct {
+	Enabled   bool   `yaml:"enabled"`
+	CacheDir  string `yaml:"cache_dir"`   // 다운로드/변환 이미지 캐시 디렉토리
+	MaxWidth  int    `yaml:"max_width"`   // 가로가 넘으면 축소 (px)
+	MaxSizeKB int    `yaml:"max_size_kb"` // 넘으면 JPEG로 다시 압축 (KB)
+	LocalDir  string `yaml:"local_dir"`   // 로컬 이미지를 읽을 디렉토리 (빈 값 = 로컬 이미지를 올리지 않음)
+}
+
+// DefaultMaxOverlap history.max_overlap 생략 시 허용 중복 비율
+const DefaultMaxOverlap = 0.5
+
+// 중복 시 동작 (history.action)
+const (
+	HistoryActionSkip       = "skip"       // 건너뜀
+	HistoryActionRegenerate = "regenerate" // 겹친 항목 제외 후 재생성
+)
+
+// HistoryConfig 발행 이력 및 중복 포스트 방지 설정
+type HistoryConfig struct {
+	Enabled     bool     `yaml:"enabled"`
+	Dir         string   `yaml:"dir"`          // 이력 저장 디렉토리
+	WindowHours int      `yaml:"window_hours"` // 중복 검사 기간 (시간)
+	MaxOverlap  *float64 `yaml:"max_overlap"`  // 허용 최대 소스 항목 중복 비율 (0~1, 생략 시 0.5, 0 = 하나라도 겹치면 중복)
+	Action      string   `yaml:"action"`       // 중복 시 동작: skip | regenerate
+}
+
+// storeDir 사용 중인 이력 저장 디렉토리 (비활성화 = 빈 값)
+func (h *HistoryConfig) storeDir() string {
+	if h == nil || !h.Enabled {
+		return ""
+	}
+	return h.Dir
+}
+
+// QueueConfig 스케줄 작업 큐 설정 (재시도/재시작 시 놓친 작업 실행)
+type QueueConfig struct {
+	Dir          string            `yaml:"dir"`           // 큐 저장 디렉토리
+	MaxAttempts  int               `yaml:"max_attempts"`  // 최대 시도 횟수 (첫 실행 포함)
+	Concurrency  int               `yaml:"concurrency"`   // 동시에 포스팅하는 계정 수 (계정 안에서는 항상 1개씩)
+	RetryBase    string            `yaml:"retry_base"`    // 첫 재시도 대기 (이후 2배씩 증가, 예: "2m")
+	RetryMax     string            `yaml:"retry_max"`     // 재시도 대기 상한 (예: "30m")
+	CatchUp      map[string]string `yaml:"catch_up"`      // 카테고리별 놓친 작업 허용 기간 ("default" = 기본값, "0" = 실행 안 함)
+	DrainTimeout string            `yaml:"drain_timeout"` // 종료 시 실행 중인 작업을 기다리는 최대 시간 (예: "3m")
+}
+
+// RunsConfig 실행 기록 설정 (post/run/스케줄 작업마다 1건, status 명령에서 조회)
+type RunsConfig struct {
+	Dir string `yaml:"dir"` // 실행 기록 저장 디렉토리
+}
+
+// DefaultCatchUp catch_up 미지정 카테고리의 놓친 작업 허용 기간
+const DefaultCatchUp = 2 * time.Hour
+
+// RetryDelays 재시도 대기 시간 (retry_base, retry_max)
+func (q *QueueConfig) RetryDelays() (base, max time.Duration, err error) {
+	if base, err = time.ParseDuration(q.RetryBase); err != nil {
+		return 0, 0, fmt.Errorf("queue.retry_base 형식 오류: %w", err)
+	}
+	if max, err = time.ParseDuration(q.RetryMax); err != nil {
+		return 0, 0, fmt.Errorf("queue.retry_max 형식 오류: %w", err)
+	}
+	return base, max, nil
+}
+
+// CatchUpWindow 카테고리의 놓친 작업 허용 기간 (이보다 오래된 작업은 건너뜀, 0 = 실행 안 함)
+func (q *QueueConfig) CatchUpWindow(category string) (time.Duration, error) {
+	value, ok := q.CatchUp[category]
+	if !ok {
+		value, ok = q.CatchUp["default"]
+	}
+	if !ok {
+		return DefaultCatchUp, nil
+	}
+	if value == "0" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("queue.catch_up.%s 형식 오류: %w", category, err)
+	}
+	return d, nil
+}
+
+// DrainTimeoutDuration 종료 시 실행 중인 작업 대기 시간 (지나면 작업을 취소)
+func (q *QueueConfig) DrainTimeoutDuration() (time.Duration, error) {
+	d, err := time.ParseDuration(q.DrainTimeout)
+	if err != nil {
+		return 0, fmt.Errorf("queue.drain_timeout 형식 오류: %w", err)
+	}
+	return d, nil
 }
 
 // AccountConfig 개별 계정 설정
@@ -43,6 +171,7 @@ type AccountConfig struct {
 	Naver      NaverConfig       `yaml:"naver"`      // 네이버 API 설정
 	Categories map[string]string `yaml:"categories"` // 카테고리 매핑
 	Schedule   ScheduleConfig    `yaml:"schedule"`   // 스케줄 설정
+	Theme      string            `yaml:"theme"`      // 본문 테마 이름 (빈 값 = 내장 default)
 }
 
 // TistoryConfig 티스토리 설정 (브라우저 자동화용)
@@ -54,8 +183,10 @@ type TistoryConfig struct {
 
 // BrowserConfig 브라우저 설정
 type BrowserConfig struct {
-	Headless   bool `yaml:"headless"`
-	SlowMotion int  `yaml:"slow_motion"`
+	Headless        bool   `yaml:"headless"`
+	SlowMotion      int    `yaml:"slow_motion"`
+	SelectorProfile string `yaml:"selector_profile"` // 에디터 셀렉터 프로필 YAML (빈 값 = 내장 기본값)
+	ScreenshotDir   string `yaml:"screenshot_dir"`   // 발행 단계 실패 시 화면 저장 (생략 시 ./screenshots)
 }
 
 // TMDBConfig TMDB API 설정
@@ -78,31 +209,160 @@ type CoupangConfig struct {
 
 // ScheduleConfig 스케줄 설정
 type ScheduleConfig struct {
-	Enabled bool          `yaml:"enabled"`
-	Jobs    []ScheduleJob `yaml:"jobs"`
+	Enabled  bool            `yaml:"enabled"`
+	MinGap   string          `yaml:"min_gap"`  // 같은 계정 포스팅 사이 최소 간격 (예: "10m", 생략 시 5m)
+	Humanize *HumanizeConfig `yaml:"humanize"` // 계정 전체 발행 시각 규칙 (생략 시 jitter 45m)
+	Jobs     []ScheduleJob   `yaml:"jobs"`
+}
+
+// DefaultMinGap min_gap 미지정 계정의 포스팅 간 최소 간격
+const DefaultMinGap = 5 * time.Minute
+
+// MinGapDuration 같은 계정의 연속 포스팅 사이 최소 간격
+func (s *ScheduleConfig) MinGapDuration() (time.Duration, error) {
+	if s.MinGap == "" {
+		return DefaultMinGap, nil
+	}
+	d, err := time.ParseDuration(s.MinGap)
+	if err != nil {
+		return 0, fmt.Errorf("schedule.min_gap 형식 오류: %w", err)
+	}
+	return d, nil
 }
 
 // ScheduleJob 개별 스케줄 작업
 type ScheduleJob struct {
-	Category string `yaml:"category"`
-	Cron     string `yaml:"cron"`
+	Category string          `yaml:"category"`
+	Cron     string          `yaml:"cron"`
+	Publish  *PublishConfig  `yaml:"publish"`  // 발행 방식 (생략 시 즉시 공개 발행)
+	Humanize *HumanizeConfig `yaml:"humanize"` // 작업별 발행 시각 규칙 (계정 값 덮어쓰기)
+}
+
+// PublishConfig 발행 방식 설정 (검토가 필요한 카테고리용)
+type PublishConfig struct {
+	Mode         string `yaml:"mode"`          // public | protected | private | draft
+	Password     string `yaml:"password"`      // protected 모드 비밀번호
+	ReserveDelay string `yaml:"reserve_delay"` // 예약 발행: 실행 시점부터 지연 (예: "2h", "30m")
 }
 
-// Load 설정 파일 로드
+// Load 설정 파일 로드 (수집기 레지스트리 검사 제외)
 func Load(path string) (*Config, error) {
+	return LoadWithRegistry(path, Registry{})
+}
+
+// LoadWithRegistry 설정 파일 로드 + 검증
+// 알 수 없는 필드, 잘못된 cron/기간 값, 중복 계정/블로그 이름, 레지스트리에 없는 카테고리를
+// 한 번에 모아 줄 번호와 함께 ValidationError로 보고한다.
+func LoadWithRegistry(path string, r Registry) (*Config, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	var cfg Config
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, err
+	cfg := Config{
+		Browser: BrowserConfig{Headless: true}, // headless 생략 시 기본값
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("%s: YAML 파싱 실패: %w", path, err)
+	}
+	cfg.root = &root
+
+	// 알 수 없는 필드(오타)와 비밀 참조 실패는 모아서 나머지 검증 결과와 함께 보고
+	var issues []Issue
+	if len(root.Content) > 0 {
+		var probe Config
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&probe); err != nil {
+			unknown, ok := decodeIssues(err, true)
+			if !ok {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			issues = append(issues, unknown...)
+		}
+
+		// ${ENV}, file:, vault: 참조를 실제 값으로 바꾼 트리를 디코딩
+		issues = append(issues, cfg.resolveSecrets(secrets.NewResolver(vaultPath(&root)))...)
+		if err := root.Decode(&cfg); err != nil {
+			typed, ok := decodeIssues(err, false)
+			if !ok {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			issues = append(issues, typed...)
+		}
+	}
+	if cfg.Secrets == nil {
+		cfg.Secrets = &SecretsConfig{}
+	}
+	if cfg.Secrets.Vault == "" {
+		cfg.Secrets.Vault = DefaultVaultPath
+	}
+
+	// 본문 이미지 기본값
+	if cfg.Images != nil {
+		if cfg.Images.CacheDir == "" {
+			cfg.Images.CacheDir = "./image_cache"
+		}
+		if cfg.Images.MaxWidth == 0 {
+			cfg.Images.MaxWidth = 1600
+		}
+		if cfg.Images.MaxSizeKB == 0 {
+			cfg.Images.MaxSizeKB = 2048
+		}
+	}
+
+	// 발행 이력 기본값
+	if cfg.History != nil {
+		if cfg.History.Dir == "" {
+			cfg.History.Dir = "./history_data"
+		}
+		if cfg.History.WindowHours == 0 {
+			cfg.History.WindowHours = 48
+		}
+		if cfg.History.MaxOverlap == nil {
+			overlap := DefaultMaxOverlap
+			cfg.History.MaxOverlap = &overlap
+		}
+		if cfg.History.Action == "" {
+			cfg.History.Action = HistoryActionSkip
+		}
+	}
+
+	// 작업 큐 기본값 (스케줄러는 항상 큐를 사용)
+	if cfg.Queue == nil {
+		cfg.Queue = &QueueConfig{}
+	}
+	if cfg.Queue.Dir == "" {
+		cfg.Queue.Dir = "./queue_data"
+	}
+	if cfg.Queue.MaxAttempts == 0 {
+		cfg.Queue.MaxAttempts = 3
+	}
+	if cfg.Queue.Concurrency == 0 {
+		cfg.Queue.Concurrency = 2
+	}
+	if cfg.Queue.RetryBase == "" {
+		cfg.Queue.RetryBase = "2m"
+	}
+	if cfg.Queue.RetryMax == "" {
+		cfg.Queue.RetryMax = "30m"
+	}
+	if cfg.Queue.DrainTimeout == "" {
+		cfg.Queue.DrainTimeout = "3m"
 	}
 
-	// 기본값 설정
-	if cfg.Browser.Headless == false && cfg.Browser.SlowMotion == 0 {
-		cfg.Browser.Headless = true
+	// 발행 단계 실패 화면 기본값
+	if cfg.Browser.ScreenshotDir == "" {
+		cfg.Browser.ScreenshotDir = "./screenshots"
+	}
+
+	// 실행 기록 기본값 (항상 기록)
+	if cfg.Runs == nil {
+		cfg.Runs = &RunsConfig{}
+	}
+	if cfg.Runs.Dir == "" {
+		cfg.Runs.Dir = "./runs_data"
 	}
 
 	// 하위 호환성: accounts가 없으면 기존 설정으로 단일 계정 생성
@@ -118,6 +378,12 @@ func Load(path string) (*Config, error) {
 				Schedule:   cfg.Schedule,
 			},
 		}
+		cfg.legacy = true
+	}
+
+	if issues = append(issues, cfg.validate(r)...); len(issues) > 0 {
+		sort.SliceStable(issues, func(i, j int) bool { return issues[i].Line < issues[j].Line })
+		return nil, &ValidationError{File: path, Issues: issues}
 	}
 
 	// enabled 기본값 true
@@ -128,6 +394,7 @@ func Load(path string) (*Config, error) {
 		}
 	}
 
+	cfg.registerSecrets()
 	return &cfg, nil
 }
 
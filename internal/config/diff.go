@@ -0,0 +1,83 @@
+package config
+
+import "reflect"
+
+// Changes 실행 중인 스케줄러 기준 두 설정의 차이 (활성 계정 단위)
+type Changes struct {
+	Added       []string // 새로 활성화된 계정 (브라우저 시작 + 스케줄 등록)
+	Removed     []string // 삭제/비활성화된 계정 (스케줄 해제 + 브라우저 종료)
+	Relogin     []string // 로그인 정보/브라우저 설정이 바뀐 계정 (브라우저 재시작 + 스케줄 재등록)
+	Rescheduled []string // 스케줄만 바뀐 계정 (cron 항목 재등록)
+	Updated     []string // 그 밖의 계정 설정만 바뀐 계정 (다음 포스팅부터 적용)
+	Restart     []string // 재시작해야 적용되는 설정 경로
+}
+
+// Empty 적용할 차이가 없는지
+func (c Changes) Empty() bool {
+	return len(c.Added)+len(c.Removed)+len(c.Relogin)+len(c.Rescheduled)+len(c.Updated)+len(c.Restart) == 0
+}
+
+// Diff old → cur 설정 차이 (계정 순서는 cur 기준, 삭제된 계정은 old 기준)
+// 전역 browser 설정이 바뀌면 남아 있는 모든 계정의 브라우저를 다시 시작한다.
+func Diff(old, cur *Config) Changes {
+	var ch Changes
+	before := map[string]AccountConfig{}
+	for _, acc := range old.GetEnabledAccounts() {
+		before[acc.Name] = acc
+	}
+	after := map[string]bool{}
+	browserChanged := !reflect.DeepEqual(old.Browser, cur.Browser)
+
+	for _, acc := range cur.GetEnabledAccounts() {
+		after[acc.Name] = true
+		prev, ok := before[acc.Name]
+		switch {
+		case !ok:
+			ch.Added = append(ch.Added, acc.Name)
+		case browserChanged || !reflect.DeepEqual(prev.Tistory, acc.Tistory):
+			ch.Relogin = append(ch.Relogin, acc.Name)
+		case !reflect.DeepEqual(prev.Schedule, acc.Schedule):
+			ch.Rescheduled = append(ch.Rescheduled, acc.Name)
+		case !reflect.DeepEqual(prev, acc):
+			ch.Updated = append(ch.Updated, acc.Name)
+		}
+	}
+	for _, acc := range old.GetEnabledAccounts() {
+		if !after[acc.Name] {
+			ch.Removed = append(ch.Removed, acc.Name)
+		}
+	}
+
+	// 작업 큐는 시작할 때 한 번 열고 워커 수를 정하므로 바뀌어도 재시작 전까지 그대로
+	oq, cq := old.Queue, cur.Queue
+	for _, f := range []struct {
+		path    string
+		changed bool
+	}{
+		{"queue.dir", oq.Dir != cq.Dir},
+		{"queue.max_attempts", oq.MaxAttempts != cq.MaxAttempts},
+		{"queue.concurrency", oq.Concurrency != cq.Concurrency},
+		{"queue.retry_base", oq.RetryBase != cq.RetryBase},
+		{"queue.retry_max", oq.RetryMax != cq.RetryMax},
+	} {
+		if f.changed {
+			ch.Restart = append(ch.Restart, f.path)
+		}
+	}
+	// 실행 기록 저장소도 시작할 때 한 번 연다
+	if old.Runs.Dir != cur.Runs.Dir {
+		ch.Restart = append(ch.Restart, "runs.dir")
+	}
+	// 발행 이력 저장소는 처음 쓸 때 한 번 연다 (켜고 끄기는 다음 포스팅부터 적용)
+	if od, cd := old.History.storeDir(), cur.History.storeDir(); od != "" && cd != "" && od != cd {
+		ch.Restart = append(ch.Restart, "history.dir")
+	}
+	if !reflect.DeepEqual(old.Log, cur.Log) {
+		ch.Restart = append(ch.Restart, "log")
+	}
+	// 알림 대상은 다시 읽을 때 바로 바뀌지만 일일 요약 cron은 시작할 때 등록
+	if old.Notify.summarySchedule() != cur.Notify.summarySchedule() {
+		ch.Restart = append(ch.Restart, "notify.daily_summary")
+	}
+	return ch
+}
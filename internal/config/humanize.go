@@ -0,0 +1,146 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HumanizeConfig 발행 시각을 사람처럼 흩뜨리는 규칙 (schedule 또는 개별 작업에 지정)
+// 작업에 지정한 jitter/quiet_hours는 계정 값을 덮어쓰고,
+// daily_cap/min_spacing은 지정한 단위(계정 전체 또는 해당 작업)로 따로 센다.
+type HumanizeConfig struct {
+	Jitter     string          `yaml:"jitter"`      // 트리거 후 랜덤 지연 상한 (예: "45m", "0" = 지연 없음)
+	QuietHours string          `yaml:"quiet_hours"` // 발행하지 않는 시간대 (예: "23:00-07:00", 끝 시각 이후로 미룸)
+	DailyCap   int             `yaml:"daily_cap"`   // 하루 최대 발행 수 (0 = 제한 없음, 넘는 회차는 건너뜀)
+	MinSpacing string          `yaml:"min_spacing"` // 발행 사이 최소 간격 (예: "90m")
+	Weekday    *HumanizeConfig `yaml:"weekday"`     // 평일(월~금)에 덮어쓸 값
+	Weekend    *HumanizeConfig `yaml:"weekend"`     // 주말(토·일)에 덮어쓸 값
+}
+
+// DefaultJitter jitter 미지정 시 트리거 후 랜덤 지연 상한
+const DefaultJitter = 45 * time.Minute
+
+// ForDay 요일 프로필(weekday/weekend)을 덮어쓴 설정 (nil이면 nil)
+func (h *HumanizeConfig) ForDay(day time.Weekday) *HumanizeConfig {
+	if h == nil {
+		return nil
+	}
+	profile := h.Weekday
+	if day == time.Saturday || day == time.Sunday {
+		profile = h.Weekend
+	}
+	merged := *h
+	merged.Weekday, merged.Weekend = nil, nil
+	if profile == nil {
+		return &merged
+	}
+	if profile.Jitter != "" {
+		merged.Jitter = profile.Jitter
+	}
+	if profile.QuietHours != "" {
+		merged.QuietHours = profile.QuietHours
+	}
+	if profile.DailyCap != 0 {
+		merged.DailyCap = profile.DailyCap
+	}
+	if profile.MinSpacing != "" {
+		merged.MinSpacing = profile.MinSpacing
+	}
+	return &merged
+}
+
+// JitterDuration 랜덤 지연 상한 (ok=false면 미지정)
+func (h *HumanizeConfig) JitterDuration() (d time.Duration, ok bool, err error) {
+	if h == nil || h.Jitter == "" {
+		return 0, false, nil
+	}
+	if h.Jitter == "0" {
+		return 0, true, nil
+	}
+	if d, err = time.ParseDuration(h.Jitter); err != nil {
+		return 0, false, fmt.Errorf("humanize.jitter 형식 오류: %w", err)
+	}
+	return d, true, nil
+}
+
+// MinSpacingDuration 발행 사이 최소 간격 (ok=false면 미지정)
+func (h *HumanizeConfig) MinSpacingDuration() (d time.Duration, ok bool, err error) {
+	if h == nil || h.MinSpacing == "" {
+		return 0, false, nil
+	}
+	if h.MinSpacing == "0" {
+		return 0, true, nil
+	}
+	if d, err = time.ParseDuration(h.MinSpacing); err != nil {
+		return 0, false, fmt.Errorf("humanize.min_spacing 형식 오류: %w", err)
+	}
+	return d, true, nil
+}
+
+// QuietWindow 발행하지 않는 시간대 (자정 기준 시작/끝, ok=false면 미지정)
+func (h *HumanizeConfig) QuietWindow() (start, end time.Duration, ok bool, err error) {
+	if h == nil || h.QuietHours == "" {
+		return 0, 0, false, nil
+	}
+	from, to, found := strings.Cut(h.QuietHours, "-")
+	if !found {
+		return 0, 0, false, fmt.Errorf("humanize.quiet_hours 형식 오류 (예: \"23:00-07:00\"): %s", h.QuietHours)
+	}
+	if start, err = parseClock(from); err == nil {
+		end, err = parseClock(to)
+	}
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("humanize.quiet_hours 형식 오류: %w", err)
+	}
+	if start == end {
+		return 0, 0, false, fmt.Errorf("humanize.quiet_hours 시작과 끝이 같음: %s", h.QuietHours)
+	}
+	return start, end, true, nil
+}
+
+// parseClock "HH:MM"을 자정 기준 시간으로 변환
+func parseClock(s string) (time.Duration, error) {
+	hh, mm, found := strings.Cut(strings.TrimSpace(s), ":")
+	if !found {
+		return 0, fmt.Errorf("시각은 HH:MM 형식: %q", s)
+	}
+	h, err := strconv.Atoi(hh)
+	if err != nil || h < 0 || h > 24 {
+		return 0, fmt.Errorf("잘못된 시: %q", s)
+	}
+	m, err := strconv.Atoi(mm)
+	if err != nil || m < 0 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("잘못된 분: %q", s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// validate 요일 프로필을 포함한 모든 값의 형식 검사
+func (h *HumanizeConfig) validate() error {
+	if h == nil {
+		return nil
+	}
+	for _, c := range []*HumanizeConfig{h, h.Weekday, h.Weekend} {
+		if c == nil {
+			continue
+		}
+		if c != h && (c.Weekday != nil || c.Weekend != nil) {
+			return fmt.Errorf("humanize.weekday/weekend 안에 요일 프로필을 다시 지정할 수 없음")
+		}
+		if _, _, err := c.JitterDuration(); err != nil {
+			return err
+		}
+		if _, _, err := c.MinSpacingDuration(); err != nil {
+			return err
+		}
+		if _, _, _, err := c.QuietWindow(); err != nil {
+			return err
+		}
+		if c.DailyCap < 0 {
+			return fmt.Errorf("humanize.daily_cap은 0 이상이어야 함: %d", c.DailyCap)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 알림 이벤트 이름 (notify.sinks[].events)
+const (
+	EventPublishSuccess = "publish_success" // 발행(임시저장 포함) 성공
+	EventPublishFailure = "publish_failure" // 발행 실패
+	EventLoginFailure   = "login_failure"   // 로그인 실패
+	EventCaptcha        = "captcha"         // 로그인 캡챠 요구 (수동 로그인 필요)
+	EventDailySummary   = "daily_summary"   // 일일 요약
+)
+
+// NotifyEvents 알림 이벤트 이름 목록
+var NotifyEvents = []string{EventPublishSuccess, EventPublishFailure, EventLoginFailure, EventCaptcha, EventDailySummary}
+
+// 알림 대상 종류 (notify.sinks[].type)
+var notifySinkTypes = []string{"webhook", "slack", "discord", "telegram", "email"}
+
+// DefaultNotifyTimeout notify.timeout 미지정 시 알림 1건 전송 제한 시간
+const DefaultNotifyTimeout = 10 * time.Second
+
+// NotifyConfig 실행 알림 설정
+type NotifyConfig struct {
+	DailySummary string       `yaml:"daily_summary"` // 일일 요약 발송 cron (예: "0 22 * * *", 빈 값 = 보내지 않음)
+	Timeout      string       `yaml:"timeout"`       // 알림 1건 전송 제한 시간 (기본 10s)
+	Sinks        []NotifySink `yaml:"sinks"`         // 알림 대상
+}
+
+// NotifySink 알림 대상 1개 (종류별로 필요한 값만 사용)
+type NotifySink struct {
+	Name      string   `yaml:"name"`       // 대상 이름 (로그/notify test용, 생략 시 type)
+	Type      string   `yaml:"type"`       // webhook | slack | discord | telegram | email
+	Events    []string `yaml:"events"`     // 보낼 이벤트 (빈 값 = 전체)
+	Accounts  []string `yaml:"accounts"`   // 보낼 계정 (빈 값 = 전체)
+	RateLimit string   `yaml:"rate_limit"` // 최대 전송 수 (예: "5/1h", 넘는 알림은 건너뛰고 다음 알림에 건수 표시)
+
+	URL     string            `yaml:"url"`     // webhook / slack / discord 주소
+	Headers map[string]string `yaml:"headers"` // webhook 추가 헤더 (예: Authorization)
+
+	BotToken string `yaml:"bot_token"` // telegram 봇 토큰
+	ChatID   string `yaml:"chat_id"`   // telegram 대화 ID
+	APIURL   string `yaml:"api_url"`   // telegram Bot API 주소 (기본 https://api.telegram.org)
+
+	SMTP *SMTPConfig `yaml:"smtp"` // email
+}
+
+// SMTPConfig 메일 알림 SMTP 서버 설정
+type SMTPConfig struct {
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port"` // 기본 587 (465는 TLS 직접 연결, 그 외는 가능하면 STARTTLS)
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+// TimeoutDuration 알림 1건 전송 제한 시간
+func (n *NotifyConfig) TimeoutDuration() (time.Duration, error) {
+	if n == nil || n.Timeout == "" {
+		return DefaultNotifyTimeout, nil
+	}
+	d, err := time.ParseDuration(n.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("notify.timeout 형식 오류: %w", err)
+	}
+	return d, nil
+}
+
+// summarySchedule 일일 요약 cron (설정 없음 = 빈 값)
+func (n *NotifyConfig) summarySchedule() string {
+	if n == nil {
+		return ""
+	}
+	return n.DailySummary
+}
+
+// DisplayName 대상 이름 (생략 시 type)
+func (s *NotifySink) DisplayName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Type
+}
+
+// RateLimitValue rate_limit 값 (count가 0이면 제한 없음)
+func (s *NotifySink) RateLimitValue() (count int, per time.Duration, err error) {
+	if s.RateLimit == "" {
+		return 0, 0, nil
+	}
+	n, window, ok := strings.Cut(s.RateLimit, "/")
+	if ok {
+		count, err = strconv.Atoi(strings.TrimSpace(n))
+	}
+	if ok && err == nil && count > 0 {
+		per, err = time.ParseDuration(strings.TrimSpace(window))
+	}
+	if !ok || err != nil || count <= 0 || per <= 0 {
+		return 0, 0, fmt.Errorf("rate_limit 형식 오류 (예: \"5/1h\"): %q", s.RateLimit)
+	}
+	return count, per, nil
+}
+
+// validate 종류별 필수 값 검사 (문제를 path 기준으로 report에 전달)
+func (s *NotifySink) validate(report func(msg string, path ...interface{})) {
+	switch s.Type {
+	case "webhook", "slack", "discord":
+		if s.URL == "" {
+			report(s.Type+" 알림에는 url이 필요함", "url")
+		}
+	case "telegram":
+		if s.BotToken == "" {
+			report("telegram 알림에는 bot_token이 필요함", "bot_token")
+		}
+		if s.ChatID == "" {
+			report("telegram 알림에는 chat_id가 필요함", "chat_id")
+		}
+	case "email":
+		switch {
+		case s.SMTP == nil:
+			report("email 알림에는 smtp 설정이 필요함", "smtp")
+		case s.SMTP.Host == "":
+			report("smtp.host가 비어 있음", "smtp", "host")
+		case s.SMTP.From == "":
+			report("smtp.from이 비어 있음", "smtp", "from")
+		case len(s.SMTP.To) == 0:
+			report("smtp.to가 비어 있음", "smtp", "to")
+		}
+	case "":
+		report("type이 비어 있음 ("+strings.Join(notifySinkTypes, " | ")+")", "type")
+	default:
+		report(fmt.Sprintf("알 수 없는 알림 종류: %q (%s)", s.Type, strings.Join(notifySinkTypes, " | ")), "type")
+	}
+
+	for i, event := range s.Events {
+		if !containsString(NotifyEvents, event) {
+			report(fmt.Sprintf("알 수 없는 알림 이벤트: %q (%s)", event, strings.Join(NotifyEvents, " | ")), "events", i)
+		}
+	}
+	if _, _, err := s.RateLimitValue(); err != nil {
+		report(err.Error(), "rate_limit")
+	}
+}
+
+// containsString 목록에 값이 있는지
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
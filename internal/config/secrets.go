@@ -0,0 +1,116 @@
+package config
+
+import (
+	"os"
+
+	"github.com/Song-wh/tistory-bot/internal/secrets"
+	"gopkg.in/yaml.v3"
+)
+
+// resolveSecrets YAML 트리의 비밀 참조를 실제 값으로 바꿈 (실패는 문제 목록으로)
+// secrets 섹션은 저장소 위치라 참조로 보지 않는다.
+func (c *Config) resolveSecrets(r *secrets.Resolver) []Issue {
+	c.refs = map[string]string{}
+	var issues []Issue
+
+	var walk func(n *yaml.Node, path []interface{})
+	walk = func(n *yaml.Node, path []interface{}) {
+		switch n.Kind {
+		case yaml.DocumentNode:
+			for _, child := range n.Content {
+				walk(child, path)
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				key := n.Content[i].Value
+				if len(path) == 0 && key == "secrets" {
+					continue
+				}
+				walk(n.Content[i+1], join(path, key))
+			}
+		case yaml.SequenceNode:
+			for i, child := range n.Content {
+				walk(child, join(path, i))
+			}
+		case yaml.ScalarNode:
+			ref := n.Value
+			value, ok, err := r.Resolve(ref)
+			if !ok {
+				return
+			}
+			if err != nil {
+				issues = append(issues, Issue{Line: n.Line, Path: formatPath(path), Message: "참조 해석 실패: " + err.Error()})
+				n.Value, n.Tag, n.Style = "", "", 0 // 같은 줄에 타입 오류가 겹쳐 보고되지 않도록 빈 값으로
+				return
+			}
+			c.refs[formatPath(path)] = ref
+			n.Value = value
+			if n.Style == 0 {
+				n.Tag = "" // 따옴표 없는 값은 바뀐 값으로 타입을 다시 판단 (숫자 필드 지원)
+			}
+		}
+	}
+	walk(c.root, nil)
+	return issues
+}
+
+// registerSecrets 비밀 필드 값을 출력 가림 목록에 등록 (평문으로 적힌 값 포함)
+func (c *Config) registerSecrets() {
+	secrets.Register(c.Tistory.Password, c.TMDB.APIKey, c.Naver.ClientSecret, c.Coupang.AccessKey, c.Coupang.SecretKey)
+	if c.FootballData != nil {
+		secrets.Register(c.FootballData.APIKey)
+	}
+	for _, acc := range c.Accounts {
+		secrets.Register(acc.Tistory.Password, acc.Naver.ClientSecret, acc.Coupang.AccessKey, acc.Coupang.SecretKey)
+		for _, job := range acc.Schedule.Jobs {
+			if job.Publish != nil {
+				secrets.Register(job.Publish.Password)
+			}
+		}
+	}
+	if c.Notify != nil {
+		for _, sink := range c.Notify.Sinks {
+			// slack/discord 웹훅 주소는 경로에 토큰이 들어 있음
+			secrets.Register(sink.URL, sink.BotToken)
+			for _, value := range sink.Headers {
+				secrets.Register(value)
+			}
+			if sink.SMTP != nil {
+				secrets.Register(sink.SMTP.Password)
+			}
+		}
+	}
+}
+
+// SecretRef 계정 i의 설정 값이 어디서 왔는지 (예: "vault:kakao-main", 평문이면 빈 문자열)
+func (c *Config) SecretRef(account int, path ...interface{}) string {
+	base := []interface{}{"accounts", account}
+	if c.legacy {
+		base = nil
+	}
+	return c.refs[formatPath(join(base, path...))]
+}
+
+// vaultPath YAML 트리에서 비밀 저장소 경로 (없으면 기본값)
+func vaultPath(root *yaml.Node) string {
+	var s struct {
+		Secrets *SecretsConfig `yaml:"secrets"`
+	}
+	if root.Decode(&s) == nil && s.Secrets != nil && s.Secrets.Vault != "" {
+		return s.Secrets.Vault
+	}
+	return DefaultVaultPath
+}
+
+// VaultPath 설정 파일의 비밀 저장소 경로 (참조 해석/검증 없이 읽음, 파일이 없으면 기본값)
+func VaultPath(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return DefaultVaultPath
+	}
+	var root yaml.Node
+	if yaml.Unmarshal(data, &root) != nil || len(root.Content) == 0 {
+		return DefaultVaultPath
+	}
+	return vaultPath(&root)
+}
@@ -0,0 +1,337 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// Issue 설정 파일의 문제 1건
+type Issue struct {
+	Line    int    // 설정 파일 줄 번호 (알 수 없으면 0)
+	Path    string // 설정 경로 (예: accounts[0].schedule.jobs[1].cron)
+	Message string
+}
+
+// ValidationError 설정 검증 실패 (문제 목록)
+type ValidationError struct {
+	File   string
+	Issues []Issue
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "설정 검증 실패 (%d건)", len(e.Issues))
+	for _, is := range e.Issues {
+		b.WriteString("\n  ")
+		if is.Line > 0 {
+			fmt.Fprintf(&b, "%s:%d: ", e.File, is.Line)
+		} else {
+			fmt.Fprintf(&b, "%s: ", e.File)
+		}
+		if is.Path != "" {
+			b.WriteString(is.Path + ": ")
+		}
+		b.WriteString(is.Message)
+	}
+	return b.String()
+}
+
+// Registry 수집기 레지스트리 조회 (config가 collector를 import하지 않도록 함수로 전달)
+type Registry struct {
+	HasSource   func(name string) bool // 스케줄 카테고리가 등록된 수집기인지
+	HasCategory func(key string) bool  // 카테고리 매핑 키를 내보내는 수집기가 있는지
+}
+
+// registry 레지스트리 기준 검사 (nil 함수는 건너뜀)
+func (v *validator) registry(r Registry) {
+	for i, acc := range v.cfg.Accounts {
+		base := v.accountPath(i)
+		for j, job := range acc.Schedule.Jobs {
+			if r.HasSource != nil && job.Category != "" && !r.HasSource(job.Category) {
+				v.report(fmt.Sprintf("알 수 없는 스케줄 카테고리: %s", job.Category), join(base, "schedule", "jobs", j, "category")...)
+			}
+		}
+		for _, key := range sortedKeys(acc.Categories) {
+			if r.HasCategory != nil && !r.HasCategory(key) {
+				v.report(fmt.Sprintf("이 카테고리로 글을 만드는 수집기가 없음: %q", key), join(base, "categories", key)...)
+			}
+		}
+	}
+}
+
+// validator 문제를 모아 한 번에 보고
+type validator struct {
+	cfg    *Config
+	issues []Issue
+}
+
+// report path 위치에 문제 기록 (path: 키 문자열 또는 목록 인덱스)
+func (v *validator) report(msg string, path ...interface{}) {
+	v.issues = append(v.issues, Issue{Line: v.cfg.line(path...), Path: formatPath(path), Message: msg})
+}
+
+// accountPath 계정 i의 설정 경로 (하위 호환 단일 계정은 최상위)
+func (v *validator) accountPath(i int) []interface{} {
+	if v.cfg.legacy {
+		return nil
+	}
+	return []interface{}{"accounts", i}
+}
+
+// duration 기간 값 형식 검사 (빈 값은 기본값이 채워지므로 통과)
+func (v *validator) duration(value string, path ...interface{}) {
+	if value == "" || value == "0" {
+		return
+	}
+	if _, err := time.ParseDuration(value); err != nil {
+		v.report(fmt.Sprintf("기간 형식 오류 (예: \"30m\", \"2h\"): %q", value), path...)
+	}
+}
+
+// validate 설정 값 검사 (r이 있으면 레지스트리 기준 검사 포함)
+func (c *Config) validate(r Registry) []Issue {
+	v := &validator{cfg: c}
+
+	q := c.Queue
+	v.duration(q.RetryBase, "queue", "retry_base")
+	v.duration(q.RetryMax, "queue", "retry_max")
+	v.duration(q.DrainTimeout, "queue", "drain_timeout")
+	for _, category := range sortedKeys(q.CatchUp) {
+		v.duration(q.CatchUp[category], "queue", "catch_up", category)
+	}
+	if q.MaxAttempts < 0 {
+		v.report("0 이상이어야 함", "queue", "max_attempts")
+	}
+	if q.Concurrency < 0 {
+		v.report("0 이상이어야 함", "queue", "concurrency")
+	}
+
+	if img := c.Images; img != nil {
+		if img.MaxWidth < 0 {
+			v.report("0 이상이어야 함", "images", "max_width")
+		}
+		if img.MaxSizeKB < 0 {
+			v.report("0 이상이어야 함", "images", "max_size_kb")
+		}
+	}
+
+	if h := c.History; h != nil {
+		if h.MaxOverlap != nil && (*h.MaxOverlap < 0 || *h.MaxOverlap > 1) {
+			v.report(fmt.Sprintf("0~1 사이여야 함: %g", *h.MaxOverlap), "history", "max_overlap")
+		}
+		switch h.Action {
+		case HistoryActionSkip, HistoryActionRegenerate:
+		default:
+			v.report(fmt.Sprintf("알 수 없는 동작: %q (skip | regenerate)", h.Action), "history", "action")
+		}
+	}
+
+	if l := c.Log; l != nil {
+		switch strings.ToLower(l.Level) {
+		case "", "debug", "info", "warn", "warning", "error":
+		default:
+			v.report(fmt.Sprintf("알 수 없는 로그 레벨: %q (debug | info | warn | error)", l.Level), "log", "level")
+		}
+		if l.Format != "" && l.Format != "text" && l.Format != "json" {
+			v.report(fmt.Sprintf("알 수 없는 로그 형식: %q (text | json)", l.Format), "log", "format")
+		}
+		if l.MaxSizeMB < 0 {
+			v.report("0 이상이어야 함", "log", "max_size_mb")
+		}
+		if l.MaxBackups < 0 {
+			v.report("0 이상이어야 함", "log", "max_backups")
+		}
+	}
+
+	names := map[string]int{}
+	blogs := map[string]int{}
+	for i, acc := range c.Accounts {
+		base := v.accountPath(i)
+
+		if acc.Name != "" {
+			if first, dup := names[acc.Name]; dup {
+				v.report(fmt.Sprintf("계정 이름 중복: %s (%d번 줄과 같음)", acc.Name, first), join(base, "name")...)
+			} else {
+				names[acc.Name] = c.line(join(base, "name")...)
+			}
+		}
+		if blog := acc.Tistory.BlogName; blog != "" {
+			if first, dup := blogs[blog]; dup {
+				v.report(fmt.Sprintf("블로그 이름 중복: %s (%d번 줄과 같음)", blog, first), join(base, "tistory", "blog_name")...)
+			} else {
+				blogs[blog] = c.line(join(base, "tistory", "blog_name")...)
+			}
+		}
+
+		v.duration(acc.Schedule.MinGap, join(base, "schedule", "min_gap")...)
+		if err := acc.Schedule.Humanize.validate(); err != nil {
+			v.report(err.Error(), join(base, "schedule", "humanize")...)
+		}
+		for j, job := range acc.Schedule.Jobs {
+			jobPath := join(base, "schedule", "jobs", j)
+			if job.Category == "" {
+				v.report("category가 비어 있음", jobPath...)
+			}
+			if _, err := cron.ParseStandard(job.Cron); err != nil {
+				v.report(fmt.Sprintf("cron 표현식 오류 %q: %v", job.Cron, err), join(jobPath, "cron")...)
+			}
+			if err := job.Humanize.validate(); err != nil {
+				v.report(err.Error(), join(jobPath, "humanize")...)
+			}
+			if job.Publish != nil {
+				v.duration(job.Publish.ReserveDelay, join(jobPath, "publish", "reserve_delay")...)
+			}
+		}
+	}
+	c.validateNotify(v, names)
+	v.registry(r)
+	return v.issues
+}
+
+// validateNotify 알림 설정 검사 (accounts: 목록은 있는 계정 이름이어야 함)
+func (c *Config) validateNotify(v *validator, accounts map[string]int) {
+	n := c.Notify
+	if n == nil {
+		return
+	}
+	v.duration(n.Timeout, "notify", "timeout")
+	if n.DailySummary != "" {
+		if _, err := cron.ParseStandard(n.DailySummary); err != nil {
+			v.report(fmt.Sprintf("cron 표현식 오류 %q: %v", n.DailySummary, err), "notify", "daily_summary")
+		}
+	}
+
+	sinks := map[string]int{}
+	for i, sink := range n.Sinks {
+		base := []interface{}{"notify", "sinks", i}
+		sink.validate(func(msg string, path ...interface{}) {
+			v.report(msg, join(base, path...)...)
+		})
+		if name := sink.DisplayName(); name != "" {
+			if first, dup := sinks[name]; dup {
+				v.report(fmt.Sprintf("알림 대상 이름 중복: %s (%d번 줄과 같음), name으로 구분하세요", name, first), base...)
+			} else {
+				sinks[name] = c.line(base...)
+			}
+		}
+		for j, account := range sink.Accounts {
+			if _, ok := accounts[account]; !ok {
+				v.report(fmt.Sprintf("없는 계정: %s", account), join(base, "accounts", j)...)
+			}
+		}
+	}
+}
+
+// unknownField yaml.v3 KnownFields 에러 메시지
+var unknownField = regexp.MustCompile(`^line (\d+): field (\S+) not found in type (\S+)$`)
+
+// decodeIssues yaml 타입 에러를 줄 번호가 붙은 문제 목록으로 (다른 에러면 ok=false)
+// unknownOnly면 알 수 없는 필드만 모은다.
+func decodeIssues(err error, unknownOnly bool) ([]Issue, bool) {
+	var te *yaml.TypeError
+	if !errors.As(err, &te) {
+		return nil, false
+	}
+	issues := make([]Issue, 0, len(te.Errors))
+	for _, msg := range te.Errors {
+		if m := unknownField.FindStringSubmatch(msg); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			issues = append(issues, Issue{Line: line, Message: fmt.Sprintf("알 수 없는 필드: %s (%s, 오타 확인)", m[2], m[3])})
+			continue
+		}
+		if unknownOnly {
+			continue
+		}
+		line := 0
+		if rest, ok := strings.CutPrefix(msg, "line "); ok {
+			if n, tail, found := strings.Cut(rest, ": "); found {
+				if l, err := strconv.Atoi(n); err == nil {
+					line, msg = l, tail
+				}
+			}
+		}
+		issues = append(issues, Issue{Line: line, Message: msg})
+	}
+	return issues, true
+}
+
+// line 설정 경로의 줄 번호 (경로 끝까지 못 찾으면 찾은 데까지의 줄)
+func (c *Config) line(path ...interface{}) int {
+	n := c.root
+	if n == nil {
+		return 0
+	}
+	if n.Kind == yaml.DocumentNode && len(n.Content) > 0 {
+		n = n.Content[0]
+	}
+	line := 0
+	for _, p := range path {
+		switch key := p.(type) {
+		case string:
+			if n.Kind != yaml.MappingNode {
+				return line
+			}
+			found := false
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				if n.Content[i].Value == key {
+					line, n, found = n.Content[i].Line, n.Content[i+1], true
+					break
+				}
+			}
+			if !found {
+				return line
+			}
+		case int:
+			if n.Kind != yaml.SequenceNode || key >= len(n.Content) {
+				return line
+			}
+			n = n.Content[key]
+			line = n.Line
+		}
+	}
+	return line
+}
+
+// formatPath 경로를 accounts[0].schedule.jobs[1] 형태 문자열로
+func formatPath(path []interface{}) string {
+	var b strings.Builder
+	for _, p := range path {
+		switch key := p.(type) {
+		case int:
+			fmt.Fprintf(&b, "[%d]", key)
+		case string:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			if strings.ContainsAny(key, "./[] ") {
+				key = strconv.Quote(key)
+			}
+			b.WriteString(key)
+		}
+	}
+	return b.String()
+}
+
+// join 경로 이어 붙이기 (base를 변경하지 않음)
+func join(base []interface{}, rest ...interface{}) []interface{} {
+	path := make([]interface{}, 0, len(base)+len(rest))
+	return append(append(path, base...), rest...)
+}
+
+// sortedKeys 맵 키 정렬 (문제 보고 순서 고정)
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
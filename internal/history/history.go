@@ -0,0 +1,169 @@
+package history
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Record 발행 이력 1건
+type Record struct {
+	Account     string    `json:"account"`
+	Category    string    `json:"category"`
+	Title       string    `json:"title"`
+	ContentHash string    `json:"content_hash"`
+	SourceIDs   []string  `json:"source_ids"`
+	PostID      string    `json:"post_id"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"created_at"`   // 포스트 생성 시각
+	PublishedAt time.Time `json:"published_at"` // 발행 완료 시각
+}
+
+// Store 파일 기반 발행 이력 저장소 ({dir}/{account}/{category}.jsonl)
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewStore 저장소 생성
+func NewStore(dir string) *Store {
+	os.MkdirAll(dir, 0755)
+	return &Store{dir: dir}
+}
+
+// path 계정/카테고리별 이력 파일 경로
+func (s *Store) path(account, category string) string {
+	return filepath.Join(s.dir, sanitize(account), sanitize(category)+".jsonl")
+}
+
+// Add 이력 추가 (append-only)
+func (s *Store) Add(rec Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := s.path(rec.Account, rec.Category)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("이력 디렉토리 생성 실패: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("이력 파일 열기 실패: %w", err)
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// Recent since 이후 발행된 이력 조회 (오래된 순)
+func (s *Store) Recent(account, category string, since time.Time) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(s.path(account, category))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			continue // 깨진 줄은 무시
+		}
+		if !rec.PublishedAt.Before(since) {
+			records = append(records, rec)
+		}
+	}
+	return records, scanner.Err()
+}
+
+// Match 중복 검사 결과
+type Match struct {
+	Record   Record  // 가장 많이 겹치는 이전 포스트
+	Overlap  float64 // 겹치는 소스 항목 비율 (0~1)
+	SameHash bool    // 본문 해시 동일 여부
+}
+
+// FindOverlap 최근 이력 중 가장 많이 겹치는 포스트 조회 (없으면 nil)
+func FindOverlap(records []Record, contentHash string, sourceIDs []string) *Match {
+	var best *Match
+	for _, rec := range records {
+		m := Match{
+			Record:   rec,
+			Overlap:  overlap(sourceIDs, rec.SourceIDs),
+			SameHash: contentHash != "" && rec.ContentHash == contentHash,
+		}
+		if m.SameHash {
+			m.Overlap = 1
+		}
+		if best == nil || m.Overlap > best.Overlap {
+			best = &m
+		}
+	}
+	return best
+}
+
+// SeenIDs 최근 이력에 등장한 소스 항목 집합
+func SeenIDs(records []Record) map[string]bool {
+	seen := make(map[string]bool)
+	for _, rec := range records {
+		for _, id := range rec.SourceIDs {
+			seen[id] = true
+		}
+	}
+	return seen
+}
+
+// ContentHash 본문 SHA-256 해시
+func ContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// overlap ids 중 prev에 포함된 비율
+func overlap(ids, prev []string) float64 {
+	if len(ids) == 0 || len(prev) == 0 {
+		return 0
+	}
+	prevSet := make(map[string]bool, len(prev))
+	for _, id := range prev {
+		prevSet[id] = true
+	}
+	hit := 0
+	for _, id := range ids {
+		if prevSet[id] {
+			hit++
+		}
+	}
+	return float64(hit) / float64(len(ids))
+}
+
+// sanitize 파일명에 쓸 수 없는 문자 치환
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,226 @@
+package humanize
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/robfig/cron/v3"
+)
+
+// Rules 트리거 1회에 적용되는 규칙 (계정/작업 설정과 요일 프로필을 합친 결과)
+type Rules struct {
+	Jitter         time.Duration // 트리거 후 랜덤 지연 상한
+	Quiet          bool          // 조용한 시간대 사용 여부
+	QuietStart     time.Duration // 자정 기준 시작
+	QuietEnd       time.Duration // 자정 기준 끝 (시작보다 작으면 자정을 넘김)
+	AccountCap     int           // 계정 전체 하루 최대 발행 수 (0 = 제한 없음)
+	JobCap         int           // 이 작업의 하루 최대 발행 수
+	AccountSpacing time.Duration // 계정 전체 발행 사이 최소 간격
+	JobSpacing     time.Duration // 이 작업 발행 사이 최소 간격
+}
+
+// RulesFor day 요일에 acc의 job 트리거에 적용할 규칙
+// jitter/quiet_hours는 기본값 ← 계정 ← 작업 순으로 덮어쓰고, 계정 min_spacing 기본값은 schedule.min_gap.
+func RulesFor(acc *config.AccountConfig, job *config.ScheduleJob, day time.Weekday) (Rules, error) {
+	accCfg := acc.Schedule.Humanize.ForDay(day)
+	jobCfg := job.Humanize.ForDay(day)
+
+	r := Rules{Jitter: config.DefaultJitter}
+	gap, err := acc.Schedule.MinGapDuration()
+	if err != nil {
+		return r, err
+	}
+	r.AccountSpacing = gap
+
+	for _, c := range []*config.HumanizeConfig{accCfg, jobCfg} {
+		if d, ok, err := c.JitterDuration(); err != nil {
+			return r, err
+		} else if ok {
+			r.Jitter = d
+		}
+		if start, end, ok, err := c.QuietWindow(); err != nil {
+			return r, err
+		} else if ok {
+			r.Quiet, r.QuietStart, r.QuietEnd = true, start, end
+		}
+	}
+
+	if accCfg != nil {
+		r.AccountCap = accCfg.DailyCap
+		if d, ok, err := accCfg.MinSpacingDuration(); err != nil {
+			return r, err
+		} else if ok {
+			r.AccountSpacing = d
+		}
+	}
+	if jobCfg != nil {
+		r.JobCap = jobCfg.DailyCap
+		if d, ok, err := jobCfg.MinSpacingDuration(); err != nil {
+			return r, err
+		} else if ok {
+			r.JobSpacing = d
+		}
+	}
+	return r, nil
+}
+
+// QuietUntil t가 조용한 시간대 안이면 그 시간대가 끝나는 시각 (밖이면 ok=false)
+func (r Rules) QuietUntil(t time.Time) (time.Time, bool) {
+	if !r.Quiet {
+		return time.Time{}, false
+	}
+	midnight := dayStart(t)
+	offset := t.Sub(midnight)
+	if r.QuietStart < r.QuietEnd {
+		if offset >= r.QuietStart && offset < r.QuietEnd {
+			return midnight.Add(r.QuietEnd), true
+		}
+		return time.Time{}, false
+	}
+	// 자정을 넘기는 시간대 (예: 23:00-07:00)
+	switch {
+	case offset >= r.QuietStart:
+		return dayStart(midnight.Add(36 * time.Hour)).Add(r.QuietEnd), true
+	case offset < r.QuietEnd:
+		return midnight.Add(r.QuietEnd), true
+	}
+	return time.Time{}, false
+}
+
+// AvoidQuiet t가 조용한 시간대 안이면 끝나는 시각으로 미룸
+func (r Rules) AvoidQuiet(t time.Time) time.Time {
+	if until, ok := r.QuietUntil(t); ok {
+		return until
+	}
+	return t
+}
+
+// Slot 트리거 1회의 실제 실행 계획
+type Slot struct {
+	Account   string
+	Job       config.ScheduleJob
+	TriggerAt time.Time // cron 트리거 시각
+	RunAt     time.Time // 지연/조용한 시간/간격을 반영한 실행 시각
+	Skipped   string    // 건너뛰는 이유 (빈 값 = 실행)
+}
+
+// lookback 계획 계산을 시작하는 시점 (하루 전 자정부터 계산해야 간격/상한이 이어짐)
+const lookback = 24 * time.Hour
+
+// PlanAccount acc의 스케줄 중 트리거 시각이 [from, to)인 회차의 실행 계획
+// 지연은 계정/카테고리/트리거 시각으로 시드를 정해 같은 트리거는 항상 같은 시각이 나온다.
+func PlanAccount(acc *config.AccountConfig, from, to time.Time) ([]Slot, error) {
+	if !acc.Schedule.Enabled {
+		return nil, nil
+	}
+	start := dayStart(from).Add(-lookback)
+
+	// 모든 작업의 트리거를 시간순으로 펼침
+	var slots []Slot
+	for _, job := range acc.Schedule.Jobs {
+		sched, err := cron.ParseStandard(job.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("계정 %s: %s 작업 cron 오류: %w", acc.Name, job.Category, err)
+		}
+		for t := sched.Next(start.Add(-time.Second)); t.Before(to); t = sched.Next(t) {
+			slots = append(slots, Slot{Account: acc.Name, Job: job, TriggerAt: t})
+		}
+	}
+	sort.SliceStable(slots, func(i, j int) bool { return slots[i].TriggerAt.Before(slots[j].TriggerAt) })
+
+	var lastAccount time.Time
+	lastJob := map[string]time.Time{}
+	accountCount := map[string]int{}        // 날짜 → 계정 발행 수
+	jobCount := map[string]map[string]int{} // 카테고리 → 날짜 → 발행 수
+
+	var plan []Slot
+	for i := range slots {
+		s := &slots[i]
+		rules, err := RulesFor(acc, &s.Job, s.TriggerAt.Weekday())
+		if err != nil {
+			return nil, fmt.Errorf("계정 %s: %s 작업: %w", acc.Name, s.Job.Category, err)
+		}
+		rng := rand.New(rand.NewSource(seed(acc.Name, s.Job.Category, s.TriggerAt)))
+
+		run := s.TriggerAt.Add(randDuration(rng, rules.Jitter))
+		if until, ok := rules.QuietUntil(run); ok {
+			// 조용한 시간이 끝나자마자 몰리지 않도록 다시 흩뜨림
+			run = until.Add(randDuration(rng, rules.Jitter))
+		}
+		run = spaced(run, lastAccount, rules.AccountSpacing)
+		run = spaced(run, lastJob[s.Job.Category], rules.JobSpacing)
+		run = rules.AvoidQuiet(run)
+		s.RunAt = run.Truncate(time.Second)
+
+		day := s.RunAt.Format("2006-01-02")
+		if jobCount[s.Job.Category] == nil {
+			jobCount[s.Job.Category] = map[string]int{}
+		}
+		switch {
+		case rules.AccountCap > 0 && accountCount[day] >= rules.AccountCap:
+			s.Skipped = fmt.Sprintf("계정 하루 상한 %d개", rules.AccountCap)
+		case rules.JobCap > 0 && jobCount[s.Job.Category][day] >= rules.JobCap:
+			s.Skipped = fmt.Sprintf("작업 하루 상한 %d개", rules.JobCap)
+		default:
+			accountCount[day]++
+			jobCount[s.Job.Category][day]++
+			lastAccount = s.RunAt
+			lastJob[s.Job.Category] = s.RunAt
+		}
+
+		if !s.TriggerAt.Before(from) {
+			plan = append(plan, *s)
+		}
+	}
+	return plan, nil
+}
+
+// SlotFor 트리거 1회의 실행 계획 (PlanAccount와 같은 결과)
+func SlotFor(acc *config.AccountConfig, job config.ScheduleJob, triggerAt time.Time) (Slot, error) {
+	slots, err := PlanAccount(acc, triggerAt, triggerAt.Add(time.Minute))
+	if err != nil {
+		return Slot{}, err
+	}
+	for _, s := range slots {
+		if s.Job.Category == job.Category && s.TriggerAt.Equal(triggerAt) {
+			return s, nil
+		}
+	}
+	return Slot{}, fmt.Errorf("계정 %s: %s 작업에 %s 트리거 없음", acc.Name, job.Category, triggerAt.Format("01/02 15:04"))
+}
+
+// spaced last 이후 최소 간격을 지킨 시각
+func spaced(t, last time.Time, spacing time.Duration) time.Time {
+	if spacing <= 0 || last.IsZero() {
+		return t
+	}
+	if earliest := last.Add(spacing); t.Before(earliest) {
+		return earliest
+	}
+	return t
+}
+
+// randDuration [0, max) 범위 랜덤 시간
+func randDuration(rng *rand.Rand, max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rng.Int63n(int64(max)))
+}
+
+// seed 계정/카테고리/트리거 시각으로 정해지는 난수 시드
+func seed(account, category string, triggerAt time.Time) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%s/%d", account, category, triggerAt.Unix())
+	return int64(h.Sum64())
+}
+
+// dayStart t가 속한 날의 자정
+func dayStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,145 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HumanHandler 사람이 읽는 한 줄 형식 핸들러
+//
+//	15:04:05 [계정/카테고리] ▶️ 포스팅 시작 job=계정/카테고리/20250101T030000Z
+//	15:04:05 WARN [계정/카테고리] ⚠️ 썸네일 생성 실패 err="..."
+//
+// account/category 속성은 대괄호 접두어로, 나머지 속성은 key=value로 붙인다.
+type HumanHandler struct {
+	w     io.Writer
+	mu    *sync.Mutex
+	level slog.Leveler
+
+	account  string
+	category string
+	attrs    []slog.Attr // With로 붙인 나머지 속성 (그룹 접두어 적용됨)
+	group    string      // WithGroup 접두어 ("a.b.")
+}
+
+// NewHumanHandler w에 쓰는 사람용 핸들러 (opts가 nil이면 info 레벨)
+func NewHumanHandler(w io.Writer, opts *slog.HandlerOptions) *HumanHandler {
+	h := &HumanHandler{w: w, mu: &sync.Mutex{}, level: slog.LevelInfo}
+	if opts != nil && opts.Level != nil {
+		h.level = opts.Level
+	}
+	return h
+}
+
+func (h *HumanHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *HumanHandler) Handle(_ context.Context, r slog.Record) error {
+	account, category := h.account, h.category
+	var rest []slog.Attr
+	r.Attrs(func(a slog.Attr) bool {
+		if h.group == "" && a.Key == KeyAccount {
+			account = a.Value.String()
+		} else if h.group == "" && a.Key == KeyCategory {
+			category = a.Value.String()
+		} else {
+			rest = append(rest, a)
+		}
+		return true
+	})
+
+	var b bytes.Buffer
+	t := r.Time
+	if t.IsZero() {
+		t = time.Now()
+	}
+	b.WriteString(t.Format("15:04:05"))
+	if r.Level != slog.LevelInfo {
+		b.WriteByte(' ')
+		b.WriteString(r.Level.String())
+	}
+	switch {
+	case account != "" && category != "":
+		fmt.Fprintf(&b, " [%s/%s]", account, category)
+	case account != "":
+		fmt.Fprintf(&b, " [%s]", account)
+	case category != "":
+		fmt.Fprintf(&b, " [%s]", category)
+	}
+	b.WriteByte(' ')
+	b.WriteString(r.Message)
+	for _, a := range h.attrs {
+		appendAttr(&b, "", a)
+	}
+	for _, a := range rest {
+		appendAttr(&b, h.group, a)
+	}
+	b.WriteByte('\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := h.w.Write(b.Bytes())
+	return err
+}
+
+func (h *HumanHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	c.attrs = append([]slog.Attr(nil), h.attrs...)
+	for _, a := range attrs {
+		switch {
+		case h.group == "" && a.Key == KeyAccount:
+			c.account = a.Value.String()
+		case h.group == "" && a.Key == KeyCategory:
+			c.category = a.Value.String()
+		default:
+			a.Key = h.group + a.Key
+			c.attrs = append(c.attrs, a)
+		}
+	}
+	return &c
+}
+
+func (h *HumanHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	c := *h
+	c.group = h.group + name + "."
+	return &c
+}
+
+// appendAttr " key=value" 추가 (그룹은 key를 점으로 이어 펼침)
+func appendAttr(b *bytes.Buffer, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		for _, g := range a.Value.Group() {
+			appendAttr(b, prefix+a.Key+".", g)
+		}
+		return
+	}
+	b.WriteByte(' ')
+	b.WriteString(prefix + a.Key)
+	b.WriteByte('=')
+	var s string
+	switch a.Value.Kind() {
+	case slog.KindTime:
+		s = a.Value.Time().Format("2006-01-02 15:04:05")
+	default:
+		s = a.Value.String()
+	}
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		s = strconv.Quote(s)
+	}
+	b.WriteString(s)
+}
@@ -0,0 +1,133 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/secrets"
+)
+
+// 모든 줄에 붙는 작업 속성 키
+const (
+	KeyAccount  = "account"  // 계정 이름
+	KeyCategory = "category" // 수집기 카테고리
+	KeyJob      = "job"      // 큐 작업 ID (계정/카테고리/트리거 시각)
+)
+
+type ctxKey struct{}
+
+// With ctx의 로거에 속성을 붙인 새 컨텍스트 (이후 From(ctx)로 꺼낸 로거는 모든 줄에 속성 포함)
+func With(ctx context.Context, args ...interface{}) context.Context {
+	return context.WithValue(ctx, ctxKey{}, From(ctx).With(args...))
+}
+
+// From ctx에 담긴 로거 (없으면 기본 로거)
+func From(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(ctxKey{}).(*slog.Logger); ok {
+			return l
+		}
+	}
+	return slog.Default()
+}
+
+// Setup 설정으로 기본 로거 구성 (콘솔 + 선택적 회전 로그 파일)
+// cfg가 nil이면 콘솔 text/info. 반환한 Closer로 로그 파일을 닫는다.
+func Setup(cfg *config.LogConfig, console io.Writer) (io.Closer, error) {
+	if cfg == nil {
+		cfg = &config.LogConfig{}
+	}
+	level, err := ParseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	switch cfg.Format {
+	case "", "text":
+		handler = NewHumanHandler(console, opts)
+	case "json":
+		handler = slog.NewJSONHandler(console, opts)
+	default:
+		return nil, fmt.Errorf("알 수 없는 로그 형식: %s (text | json)", cfg.Format)
+	}
+
+	var closer io.Closer = nopCloser{}
+	if cfg.File != "" {
+		file, err := OpenRotating(cfg.File, cfg.MaxSizeMB, cfg.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		// 로그 파일은 항상 JSON 줄 (콘솔처럼 표준 출력 필터를 거치지 않으므로 직접 가림)
+		handler = multiHandler{handler, slog.NewJSONHandler(secrets.NewRedactWriter(file), opts)}
+		closer = file
+	}
+
+	slog.SetDefault(slog.New(handler))
+	return closer, nil
+}
+
+// ParseLevel 로그 레벨 이름 (빈 값 = info)
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(name) {
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "debug":
+		return slog.LevelDebug, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("알 수 없는 로그 레벨: %s (debug | info | warn | error)", name)
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// multiHandler 여러 핸들러에 같은 레코드 전달 (콘솔 + 파일)
+type multiHandler []slog.Handler
+
+func (m multiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range m {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m multiHandler) Handle(ctx context.Context, r slog.Record) error {
+	var first error
+	for _, h := range m {
+		if !h.Enabled(ctx, r.Level) {
+			continue
+		}
+		if err := h.Handle(ctx, r.Clone()); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (m multiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	out := make(multiHandler, len(m))
+	for i, h := range m {
+		out[i] = h.WithAttrs(attrs)
+	}
+	return out
+}
+
+func (m multiHandler) WithGroup(name string) slog.Handler {
+	out := make(multiHandler, len(m))
+	for i, h := range m {
+		out[i] = h.WithGroup(name)
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package media
+
+import (
+	"html"
+	"regexp"
+)
+
+// imgSrcRe <img ... src="..."> 의 src 값 (작은따옴표 포함)
+var imgSrcRe = regexp.MustCompile(`(?i)(<img\b[^>]*?\ssrc\s*=\s*)("([^"]*)"|'([^']*)')`)
+
+// Sources 본문 HTML의 <img src> 목록 (등장 순서, 중복 제외, HTML 엔티티 해제)
+func Sources(content string) []string {
+	var srcs []string
+	seen := make(map[string]bool)
+	for _, m := range imgSrcRe.FindAllStringSubmatch(content, -1) {
+		src := html.UnescapeString(m[3] + m[4])
+		if src != "" && !seen[src] {
+			seen[src] = true
+			srcs = append(srcs, src)
+		}
+	}
+	return srcs
+}
+
+// Rewrite 본문 HTML의 <img src>를 urls(원래 주소 → 새 주소)로 교체 (없는 주소는 그대로)
+func Rewrite(content string, urls map[string]string) string {
+	if len(urls) == 0 {
+		return content
+	}
+	return imgSrcRe.ReplaceAllStringFunc(content, func(tag string) string {
+		m := imgSrcRe.FindStringSubmatch(tag)
+		next, ok := urls[html.UnescapeString(m[3]+m[4])]
+		if !ok {
+			return tag
+		}
+		return m[1] + `"` + html.EscapeString(next) + `"`
+	})
+}
@@ -0,0 +1,223 @@
+// Package media 본문 이미지 다운로드 캐시와 업로드 전 변환
+//
+// 원격 이미지(TMDB 포스터, 쿠팡 썸네일 등)나 로컬 파일을 받아 크기/형식을 맞춘 뒤
+// 캐시 디렉토리에 저장한다. 같은 주소는 다시 받지 않는다.
+// 로컬 파일은 Options.LocalDir 안에 있을 때만 읽는다.
+//
+//	cache, err := media.NewCache("./image_cache", media.Options{MaxWidth: 1600, MaxBytes: 2 << 20})
+//	path, err := cache.Fetch(ctx, "https://image.tmdb.org/t/p/w500/abc.jpg")
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// maxDownload 원본 이미지 최대 크기 (이보다 크면 받지 않음)
+const maxDownload = 20 << 20
+
+// userAgent 이미지 요청 User-Agent (기본 Go 클라이언트는 차단하는 CDN이 있음)
+const userAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0 Safari/537.36"
+
+// ErrTooLarge 원본이나 변환 결과가 크기 제한을 넘음
+var ErrTooLarge = errors.New("이미지가 너무 큼")
+
+// ErrUnsupportedSource 받지 않는 이미지 주소 (http/https/file 외의 스킴, LocalDir 밖의 로컬 파일)
+var ErrUnsupportedSource = errors.New("지원하지 않는 이미지 주소")
+
+// Options 업로드 전 변환 기준과 읽을 수 있는 로컬 경로
+type Options struct {
+	MaxWidth int    // 넘으면 비율을 유지해 축소 (0 = 제한 없음)
+	MaxBytes int    // 넘으면 JPEG로 다시 압축 (0 = 제한 없음)
+	LocalDir string // 로컬 이미지를 읽을 수 있는 디렉토리 (빈 값 = 로컬 이미지를 읽지 않음)
+}
+
+// Cache 변환된 이미지 캐시 ({dir}/{주소 해시}.{jpg|png|gif})
+type Cache struct {
+	dir    string
+	opts   Options
+	client *http.Client
+}
+
+// NewCache 캐시 생성 (캐시 디렉토리가 없으면 만듦)
+func NewCache(dir string, opts Options) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("이미지 캐시 디렉토리 생성 실패: %w", err)
+	}
+	return &Cache{
+		dir:    dir,
+		opts:   opts,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Supported src를 받을 수 있는 주소인지 (아니면 Fetch가 ErrUnsupportedSource)
+func (c *Cache) Supported(src string) bool {
+	_, _, err := c.resolve(src)
+	return err == nil
+}
+
+// Fetch src(http/https 주소, LocalDir 안의 file:// 주소나 경로)를 변환해 캐시에 저장하고 파일 경로 반환
+// 이미 변환된 이미지가 있으면 다시 받지 않는다.
+func (c *Cache) Fetch(ctx context.Context, src string) (string, error) {
+	local, remote, err := c.resolve(src)
+	if err != nil {
+		return "", err
+	}
+	key := src
+	if !remote {
+		info, err := os.Stat(local)
+		if err != nil {
+			return "", fmt.Errorf("이미지 파일 확인 실패: %w", err)
+		}
+		// 로컬 파일은 내용이 바뀌면 다시 변환
+		key = fmt.Sprintf("%s|%d|%d", local, info.Size(), info.ModTime().UnixNano())
+	}
+	sum := sha256.Sum256([]byte(key))
+	base := filepath.Join(c.dir, hex.EncodeToString(sum[:])[:24])
+	for _, ext := range []string{".jpg", ".png", ".gif"} {
+		if _, err := os.Stat(base + ext); err == nil {
+			return base + ext, nil
+		}
+	}
+
+	var data []byte
+	if remote {
+		data, err = c.download(ctx, src)
+	} else {
+		data, err = readLimited(local)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	out, ext, err := Normalize(data, c.opts)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return "", fmt.Errorf("이미지 캐시 디렉토리 생성 실패: %w", err)
+	}
+	// 임시 파일에 쓴 뒤 교체 (동시에 같은 이미지를 받아도 깨진 파일이 남지 않음)
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return "", fmt.Errorf("이미지 캐시 저장 실패: %w", err)
+	}
+	if _, err := tmp.Write(out); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("이미지 캐시 저장 실패: %w", err)
+	}
+	tmp.Close()
+	if err := os.Rename(tmp.Name(), base+ext); err != nil {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("이미지 캐시 저장 실패: %w", err)
+	}
+	return base + ext, nil
+}
+
+// download 원격 이미지 받기 (이미지가 아닌 응답은 오류)
+func (c *Cache) download(ctx context.Context, src string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", src, nil)
+	if err != nil {
+		return nil, fmt.Errorf("이미지 주소 오류: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "image/*")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("이미지 다운로드 실패: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("이미지 다운로드 실패: HTTP %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.HasPrefix(ct, "image/") && !strings.HasPrefix(ct, "application/octet-stream") {
+		return nil, fmt.Errorf("이미지가 아닌 응답: %s", ct)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDownload+1))
+	if err != nil {
+		return nil, fmt.Errorf("이미지 다운로드 실패: %w", err)
+	}
+	if len(data) > maxDownload {
+		return nil, fmt.Errorf("%w: %dMB 초과", ErrTooLarge, maxDownload>>20)
+	}
+	return data, nil
+}
+
+// readLimited 로컬 이미지 읽기 (크기 제한)
+func readLimited(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("이미지 파일 열기 실패: %w", err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxDownload+1))
+	if err != nil {
+		return nil, fmt.Errorf("이미지 파일 읽기 실패: %w", err)
+	}
+	if len(data) > maxDownload {
+		return nil, fmt.Errorf("%w: %dMB 초과", ErrTooLarge, maxDownload>>20)
+	}
+	return data, nil
+}
+
+// resolve src가 원격 주소면 remote=true, 로컬 파일이면 읽을 경로
+// 로컬 파일(file:// 주소, 스킴 없는 경로)은 LocalDir 안에 있을 때만 허용한다 (수집한 본문이 임의의 파일을 올리지 못하도록).
+func (c *Cache) resolve(src string) (path string, remote bool, err error) {
+	path = src
+	if u, err := url.Parse(src); err == nil {
+		switch scheme := strings.ToLower(u.Scheme); {
+		case scheme == "http" || scheme == "https":
+			return "", true, nil
+		case scheme == "file":
+			path = u.Path
+		case len(scheme) > 1: // 한 글자는 윈도우 드라이브 ("C:\...")
+			return "", false, fmt.Errorf("%w: %s", ErrUnsupportedSource, src)
+		case scheme == "" && u.Host != "": // "//host/a.jpg"
+			return "", false, fmt.Errorf("%w: %s", ErrUnsupportedSource, src)
+		}
+	}
+	if c.opts.LocalDir == "" || !within(c.opts.LocalDir, path) {
+		return "", false, fmt.Errorf("%w: 허용된 디렉토리 밖의 경로 %s", ErrUnsupportedSource, src)
+	}
+	return path, false, nil
+}
+
+// within path가 dir 안에 있는지 (심볼릭 링크는 실제 경로로 비교)
+func within(dir, path string) bool {
+	root, err := realPath(dir)
+	if err != nil {
+		return false
+	}
+	target, err := realPath(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(root, target)
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// realPath 절대 경로 (존재하면 심볼릭 링크 해제)
+func realPath(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	if real, err := filepath.EvalSymlinks(abs); err == nil {
+		return real, nil
+	}
+	return abs, nil
+}
@@ -0,0 +1,114 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // GIF 디코더 등록
+	"image/jpeg"
+	"image/png"
+
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // webp 디코더 등록 (JPEG/PNG로 변환)
+)
+
+// ErrUnsupportedFormat 디코딩할 수 없는 형식 (SVG 등)
+var ErrUnsupportedFormat = errors.New("지원하지 않는 이미지 형식")
+
+// jpegQualities 크기 제한을 맞출 때까지 차례로 시도하는 JPEG 품질
+var jpegQualities = []int{85, 75, 65, 55}
+
+// Normalize 업로드할 이미지로 변환 (반환: 데이터, 확장자)
+//
+//   - JPEG/PNG가 기준 안이면 원본 그대로
+//   - GIF는 크기 제한 안이면 그대로 (애니메이션 유지), 넘으면 첫 프레임만 변환
+//   - 그 외(webp 등)나 기준을 넘으면 MaxWidth로 축소 후 PNG 원본/투명 이미지는 PNG, 나머지는 JPEG
+//   - PNG가 MaxBytes를 넘으면 (투명 영역은 흰 배경에 합쳐) JPEG로
+func Normalize(data []byte, opts Options) ([]byte, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	fitsWidth := opts.MaxWidth <= 0 || cfg.Width <= opts.MaxWidth
+	fitsBytes := opts.MaxBytes <= 0 || len(data) <= opts.MaxBytes
+
+	switch format {
+	case "jpeg":
+		if fitsWidth && fitsBytes {
+			return data, ".jpg", nil
+		}
+	case "png":
+		if fitsWidth && fitsBytes {
+			return data, ".png", nil
+		}
+	case "gif":
+		if fitsBytes {
+			return data, ".gif", nil
+		}
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	img = fitWidth(img, opts.MaxWidth)
+
+	// PNG 원본(스크린샷/그래픽)과 투명 이미지는 PNG를 먼저 시도
+	if format == "png" || !opaque(img) {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", fmt.Errorf("PNG 변환 실패: %w", err)
+		}
+		if opts.MaxBytes <= 0 || buf.Len() <= opts.MaxBytes {
+			return buf.Bytes(), ".png", nil
+		}
+		if !opaque(img) {
+			img = flatten(img)
+		}
+	}
+
+	for _, quality := range jpegQualities {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+			return nil, "", fmt.Errorf("JPEG 변환 실패: %w", err)
+		}
+		if opts.MaxBytes <= 0 || buf.Len() <= opts.MaxBytes {
+			return buf.Bytes(), ".jpg", nil
+		}
+	}
+	return nil, "", fmt.Errorf("%w: 품질 %d로도 %dKB 초과", ErrTooLarge, jpegQualities[len(jpegQualities)-1], opts.MaxBytes>>10)
+}
+
+// fitWidth 가로가 maxWidth를 넘으면 비율을 유지해 축소
+func fitWidth(img image.Image, maxWidth int) image.Image {
+	b := img.Bounds()
+	if maxWidth <= 0 || b.Dx() <= maxWidth {
+		return img
+	}
+	h := b.Dy() * maxWidth / b.Dx()
+	if h < 1 {
+		h = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, maxWidth, h))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, b, xdraw.Src, nil)
+	return dst
+}
+
+// opaque 투명한 픽셀이 없는 이미지인지
+func opaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+// flatten 투명 영역을 흰 배경으로 채운 불투명 이미지
+func flatten(img image.Image) image.Image {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	xdraw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, xdraw.Src)
+	xdraw.Draw(dst, dst.Bounds(), img, b.Min, xdraw.Over)
+	return dst
+}
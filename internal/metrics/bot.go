@@ -0,0 +1,45 @@
+package metrics
+
+// Default 봇 지표 레지스트리 (schedule --metrics-addr의 /metrics)
+var Default = NewRegistry()
+
+// 포스팅 결과 (Posts의 result 라벨)
+const (
+	ResultPublished = "published" // 발행 (비공개/보호/예약 포함)
+	ResultDraft     = "draft"     // 임시저장
+	ResultFailed    = "failed"    // 실패 (재시도할 시도 포함)
+)
+
+// 히스토그램 구간 (초)
+var (
+	fetchBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+	stepBuckets  = []float64{0.1, 0.5, 1, 2, 5, 10, 20, 30, 60}
+)
+
+// 포스팅
+var (
+	Posts = Default.NewCounterVec("tistory_bot_posts_total",
+		"포스팅 결과 수 (result: published | draft | failed)", "account", "category", "result")
+	LastSuccess = Default.NewGaugeVec("tistory_bot_last_success_timestamp_seconds",
+		"계정의 마지막 포스팅 성공 시각 (유닉스 초)", "account")
+	PublishStep = Default.NewHistogramVec("tistory_bot_publish_step_duration_seconds",
+		"에디터 발행 단계별 소요 시간 (초)", stepBuckets, "step")
+)
+
+// 수집기
+var (
+	CollectorFetch = Default.NewHistogramVec("tistory_bot_collector_fetch_duration_seconds",
+		"수집기 HTTP 요청 소요 시간 (초, status: HTTP 상태 코드 | error)", fetchBuckets, "host", "status")
+	CollectorFallbacks = Default.NewCounterVec("tistory_bot_collector_fallback_total",
+		"API 실패로 시뮬레이션 데이터를 사용한 횟수", "source", "data")
+)
+
+// 스케줄러 상태 (/metrics 요청 때마다 갱신)
+var (
+	QueueJobs = Default.NewGaugeVec("tistory_bot_queue_jobs",
+		"작업 큐의 작업 수 (status: pending | running)", "status")
+	BrowserUp = Default.NewGaugeVec("tistory_bot_browser_up",
+		"계정 브라우저가 응답하면 1", "account")
+	LoggedIn = Default.NewGaugeVec("tistory_bot_logged_in",
+		"계정 세션이 로그인 상태면 1", "account")
+)
@@ -0,0 +1,104 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+)
+
+// DefaultSMTPPort smtp.port 미지정 시 포트 (제출용 STARTTLS)
+const DefaultSMTPPort = 587
+
+// emailSink SMTP 메일 (첫 줄 = 제목, 나머지 = 본문)
+type emailSink struct {
+	smtp config.SMTPConfig
+}
+
+func (s *emailSink) Send(ctx context.Context, e Event) error {
+	port := s.smtp.Port
+	if port == 0 {
+		port = DefaultSMTPPort
+	}
+	host := s.smtp.Host
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+
+	// 465는 처음부터 TLS, 그 외는 서버가 지원하면 STARTTLS
+	var conn net.Conn
+	var err error
+	if port == 465 {
+		conn, err = (&tls.Dialer{Config: &tls.Config{ServerName: host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("SMTP 연결 실패: %w", err)
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return fmt.Errorf("SMTP 연결 실패: %w", err)
+	}
+	defer c.Close()
+	if port != 465 {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+				return fmt.Errorf("SMTP STARTTLS 실패: %w", err)
+			}
+		}
+	}
+	if s.smtp.Username != "" {
+		if ok, _ := c.Extension("AUTH"); ok {
+			if err := c.Auth(smtp.PlainAuth("", s.smtp.Username, s.smtp.Password, host)); err != nil {
+				return fmt.Errorf("SMTP 인증 실패: %w", err)
+			}
+		}
+	}
+
+	if err := c.Mail(s.smtp.From); err != nil {
+		return fmt.Errorf("SMTP 발신자 거부: %w", err)
+	}
+	for _, to := range s.smtp.To {
+		if err := c.Rcpt(to); err != nil {
+			return fmt.Errorf("SMTP 수신자 거부 (%s): %w", to, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP 본문 전송 실패: %w", err)
+	}
+	if _, err := w.Write(s.message(e)); err != nil {
+		return fmt.Errorf("SMTP 본문 전송 실패: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("SMTP 본문 전송 실패: %w", err)
+	}
+	return c.Quit()
+}
+
+// message 메일 헤더 + 본문 (UTF-8)
+func (s *emailSink) message(e Event) []byte {
+	subject, body, _ := strings.Cut(e.Text(), "\n")
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.smtp.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(s.smtp.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", "[tistory-bot] "+subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", e.Time.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
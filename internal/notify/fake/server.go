@@ -0,0 +1,118 @@
+// Package fake 알림 대상(웹훅/Slack/Discord/Telegram Bot API, SMTP)의 로컬 대역 서버
+//
+// 실제 서비스 없이 알림 설정과 메시지를 확인하기 위한 서버로, 받은 요청을 기록하고
+// 각 서비스가 성공 시 돌려주는 응답을 흉내 낸다. FailNext로 실패 응답도 만들 수 있다.
+//
+//	srv := fake.NewServer()
+//	defer srv.Close()
+//	// notify.sinks[].url: srv.URL + "/slack", telegram api_url: srv.URL
+//	reqs := srv.Requests()
+package fake
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Request 받은 알림 요청 1건
+type Request struct {
+	Path     string
+	Header   http.Header
+	Body     []byte
+	Text     string // 메시지 (text / content 필드, 없으면 빈 값)
+	Received time.Time
+}
+
+// Recorder 받은 요청을 기록하는 http.Handler (notify listen 명령처럼 직접 띄울 때 사용)
+type Recorder struct {
+	OnRequest func(Request) // 요청마다 호출 (nil 가능)
+
+	mu       sync.Mutex
+	requests []Request
+	failNext []int
+}
+
+// ServeHTTP 요청 기록 후 서비스별 성공 응답 (FailNext로 지정한 상태 코드가 있으면 그 응답)
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "POST만 받음", http.StatusMethodNotAllowed)
+		return
+	}
+	body, _ := io.ReadAll(req.Body)
+	rec := Request{Path: req.URL.Path, Header: req.Header.Clone(), Body: body, Received: time.Now()}
+	var msg struct {
+		Text    string `json:"text"`
+		Content string `json:"content"`
+	}
+	if json.Unmarshal(body, &msg) == nil {
+		rec.Text = msg.Text
+		if rec.Text == "" {
+			rec.Text = msg.Content
+		}
+	}
+
+	r.mu.Lock()
+	r.requests = append(r.requests, rec)
+	status := 0
+	if len(r.failNext) > 0 {
+		status, r.failNext = r.failNext[0], r.failNext[1:]
+	}
+	r.mu.Unlock()
+	if r.OnRequest != nil {
+		r.OnRequest(rec)
+	}
+
+	telegram := strings.HasSuffix(req.URL.Path, "/sendMessage")
+	switch {
+	case status != 0 && telegram:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(map[string]interface{}{"ok": false, "error_code": status, "description": http.StatusText(status)})
+	case status != 0:
+		http.Error(w, http.StatusText(status), status)
+	case telegram:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"ok": true, "result": map[string]interface{}{"message_id": len(r.Requests())}})
+	default:
+		io.WriteString(w, "ok")
+	}
+}
+
+// Requests 지금까지 받은 요청 (받은 순서)
+func (r *Recorder) Requests() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Request(nil), r.requests...)
+}
+
+// FailNext 다음 요청(들)에 status로 응답 (예: 429, 500)
+func (r *Recorder) FailNext(status ...int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failNext = append(r.failNext, status...)
+}
+
+// Server httptest로 띄운 Recorder
+type Server struct {
+	*Recorder
+	URL string
+
+	srv *httptest.Server
+}
+
+// NewServer 임의 포트의 로컬 대역 서버 시작
+func NewServer() *Server {
+	rec := &Recorder{}
+	srv := httptest.NewServer(rec)
+	return &Server{Recorder: rec, URL: srv.URL, srv: srv}
+}
+
+// Close 서버 종료
+func (s *Server) Close() {
+	s.srv.Close()
+}
@@ -0,0 +1,127 @@
+package fake
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mail SMTP로 받은 메일 1건
+type Mail struct {
+	From     string
+	To       []string
+	Data     string // 헤더 + 본문 (CRLF)
+	Received time.Time
+}
+
+// SMTPServer 메일을 받아 기록만 하는 SMTP 서버 (TLS/인증 없음)
+type SMTPServer struct {
+	Addr string // 실제로 열린 주소 (host:port)
+
+	onMail    func(Mail)
+	listener  net.Listener
+	mu        sync.Mutex
+	mails     []Mail
+	closeOnce sync.Once
+}
+
+// NewSMTPServer addr에서 SMTP 대역 서버 시작 ("127.0.0.1:0" = 임의 포트, onMail은 메일마다 호출, nil 가능)
+func NewSMTPServer(addr string, onMail func(Mail)) (*SMTPServer, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &SMTPServer{Addr: l.Addr().String(), onMail: onMail, listener: l}
+	go s.serve()
+	return s, nil
+}
+
+// Mails 지금까지 받은 메일
+func (s *SMTPServer) Mails() []Mail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Mail(nil), s.mails...)
+}
+
+// Close 서버 종료
+func (s *SMTPServer) Close() error {
+	var err error
+	s.closeOnce.Do(func() { err = s.listener.Close() })
+	return err
+}
+
+func (s *SMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+// handle SMTP 대화 1회 (EHLO/HELO, MAIL, RCPT, DATA, RSET, NOOP, QUIT)
+func (s *SMTPServer) handle(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	reply := func(line string) { tp.PrintfLine("%s", line) }
+
+	reply("220 fake ESMTP")
+	var mail Mail
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			tp.PrintfLine("250-fake")
+			reply("250 8BITMIME")
+		case "HELO":
+			reply("250 fake")
+		case "MAIL":
+			mail = Mail{From: address(arg)}
+			reply("250 OK")
+		case "RCPT":
+			mail.To = append(mail.To, address(arg))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			mail.Data = strings.ReplaceAll(string(data), "\n", "\r\n")
+			mail.Received = time.Now()
+			s.mu.Lock()
+			s.mails = append(s.mails, mail)
+			s.mu.Unlock()
+			if s.onMail != nil {
+				s.onMail(mail)
+			}
+			reply("250 OK")
+		case "RSET":
+			mail = Mail{}
+			reply("250 OK")
+		case "NOOP":
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+// address "FROM:<a@b.c>" / "TO:<a@b.c>" 에서 주소만
+func address(arg string) string {
+	if _, v, ok := strings.Cut(arg, ":"); ok {
+		arg = v
+	}
+	arg, _, _ = strings.Cut(strings.TrimSpace(arg), " ")
+	return strings.Trim(arg, "<>")
+}
@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"sync"
+	"time"
+)
+
+// limiter 최근 per 동안 최대 count건만 보내는 전송 제한 (슬라이딩 윈도)
+type limiter struct {
+	mu      sync.Mutex
+	count   int
+	per     time.Duration
+	sent    []time.Time // 윈도 안에서 보낸 시각 (오래된 순)
+	dropped int         // 마지막 전송 이후 건너뛴 수
+}
+
+func newLimiter(count int, per time.Duration) *limiter {
+	return &limiter{count: count, per: per}
+}
+
+// allow now에 보내도 되는지 (보내면 그동안 건너뛴 수를 돌려주고 초기화)
+func (l *limiter) allow(now time.Time) (ok bool, dropped int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cutoff := now.Add(-l.per)
+	i := 0
+	for i < len(l.sent) && !l.sent[i].After(cutoff) {
+		i++
+	}
+	l.sent = l.sent[i:]
+
+	if len(l.sent) >= l.count {
+		l.dropped++
+		return false, 0
+	}
+	l.sent = append(l.sent, now)
+	dropped, l.dropped = l.dropped, 0
+	return true, dropped
+}
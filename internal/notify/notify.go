@@ -0,0 +1,363 @@
+// Package notify 발행 성공/실패, 로그인 실패·캡챠, 일일 요약을 웹훅/메신저/메일로 알림
+//
+// 설정의 notify.sinks마다 보낼 이벤트와 계정을 고르고(라우팅), rate_limit으로 전송 수를 제한한다.
+// 전송은 백그라운드에서 하므로 느린 웹훅이 포스팅을 막지 않으며, 실패는 로그로만 남는다.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/logging"
+)
+
+// Kind 알림 이벤트 종류
+type Kind string
+
+// 알림 이벤트 (설정의 events 이름과 같음)
+const (
+	KindPublishSuccess Kind = config.EventPublishSuccess
+	KindPublishFailure Kind = config.EventPublishFailure
+	KindLoginFailure   Kind = config.EventLoginFailure
+	KindCaptcha        Kind = config.EventCaptcha
+	KindDailySummary   Kind = config.EventDailySummary
+)
+
+// Event 알림 1건 (webhook 대상에는 이 구조 그대로 JSON으로 전송)
+type Event struct {
+	Kind       Kind      `json:"event"`
+	Time       time.Time `json:"time"`
+	Account    string    `json:"account,omitempty"`
+	Category   string    `json:"category,omitempty"`
+	Title      string    `json:"title,omitempty"`
+	URL        string    `json:"url,omitempty"`
+	Draft      bool      `json:"draft,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Hint       string    `json:"hint,omitempty"`
+	Summary    *Summary  `json:"summary,omitempty"`
+	Suppressed int       `json:"suppressed,omitempty"` // rate_limit으로 건너뛴 직전 알림 수
+}
+
+// Summary 일일 요약 (Since ~ Until 동안의 계정별 집계)
+type Summary struct {
+	Since    time.Time        `json:"since"`
+	Until    time.Time        `json:"until"`
+	Accounts []AccountSummary `json:"accounts"`
+}
+
+// AccountSummary 계정 1개의 집계
+type AccountSummary struct {
+	Account       string `json:"account"`
+	Published     int    `json:"published"`
+	Drafts        int    `json:"drafts"`
+	Failed        int    `json:"failed"`
+	LoginFailures int    `json:"login_failures"` // 캡챠 포함
+}
+
+// Text 메신저/메일용 사람이 읽는 메시지 (첫 줄은 메일 제목으로 사용)
+func (e Event) Text() string {
+	var b strings.Builder
+	label := e.Account
+	if e.Category != "" {
+		label += "/" + e.Category
+	}
+
+	switch e.Kind {
+	case KindPublishSuccess:
+		if e.Draft {
+			fmt.Fprintf(&b, "💾 [%s] 임시저장 완료: %s", label, e.Title)
+		} else {
+			fmt.Fprintf(&b, "✅ [%s] 포스팅 완료: %s", label, e.Title)
+		}
+		if e.URL != "" {
+			b.WriteString("\n" + e.URL)
+		}
+	case KindPublishFailure:
+		fmt.Fprintf(&b, "❌ [%s] 포스팅 실패", label)
+		if e.Title != "" {
+			b.WriteString(": " + e.Title)
+		}
+		b.WriteString("\n" + e.Error)
+	case KindLoginFailure:
+		fmt.Fprintf(&b, "🔐 [%s] 로그인 실패\n%s", label, e.Error)
+	case KindCaptcha:
+		fmt.Fprintf(&b, "🧩 [%s] 캡챠 확인 필요 (login 명령으로 직접 로그인하세요)\n%s", label, e.Error)
+	case KindDailySummary:
+		e.Summary.write(&b)
+	default:
+		fmt.Fprintf(&b, "[%s] %s", label, e.Kind)
+	}
+
+	if e.Hint != "" {
+		b.WriteString("\n💡 " + e.Hint)
+	}
+	if e.Suppressed > 0 {
+		fmt.Fprintf(&b, "\n(직전 알림 %d건은 rate_limit으로 생략됨)", e.Suppressed)
+	}
+	return b.String()
+}
+
+// write 일일 요약 메시지
+func (s *Summary) write(b *strings.Builder) {
+	if s == nil {
+		b.WriteString("📊 일일 요약: 집계 없음")
+		return
+	}
+	fmt.Fprintf(b, "📊 일일 요약 (%s ~ %s)", s.Since.Format("01/02 15:04"), s.Until.Format("01/02 15:04"))
+	if len(s.Accounts) == 0 {
+		b.WriteString("\n실행된 포스팅 없음")
+	}
+	for _, a := range s.Accounts {
+		fmt.Fprintf(b, "\n• %s: 발행 %d", a.Account, a.Published)
+		if a.Drafts > 0 {
+			fmt.Fprintf(b, " / 임시저장 %d", a.Drafts)
+		}
+		fmt.Fprintf(b, " / 실패 %d", a.Failed)
+		if a.LoginFailures > 0 {
+			fmt.Fprintf(b, " / 로그인 실패 %d", a.LoginFailures)
+		}
+	}
+}
+
+// forAccounts 지정한 계정만 남긴 요약 (accounts가 비면 그대로)
+func (s *Summary) forAccounts(accounts map[string]bool) *Summary {
+	if s == nil || len(accounts) == 0 {
+		return s
+	}
+	out := *s
+	out.Accounts = nil
+	for _, a := range s.Accounts {
+		if accounts[a.Account] {
+			out.Accounts = append(out.Accounts, a)
+		}
+	}
+	return &out
+}
+
+// Sink 알림 대상
+type Sink interface {
+	Send(ctx context.Context, e Event) error
+}
+
+// Result 대상 1개의 전송 결과
+type Result struct {
+	Sink       string
+	Err        error
+	Suppressed bool // rate_limit으로 건너뜀
+}
+
+// route 알림 대상 + 라우팅 규칙
+type route struct {
+	name      string
+	rateLimit string // limiter 재사용 판단용 원래 설정 값
+	sink      Sink
+	events    map[Kind]bool   // 빈 값 = 전체
+	accounts  map[string]bool // 빈 값 = 전체
+	limit     *limiter        // nil = 제한 없음
+}
+
+// match 이벤트를 이 대상에 보내는지 (일일 요약은 계정 조건 없이 해당 계정만 추려서 보냄)
+func (r *route) match(e Event) bool {
+	if len(r.events) > 0 && !r.events[e.Kind] {
+		return false
+	}
+	if e.Kind == KindDailySummary || len(r.accounts) == 0 {
+		return true
+	}
+	return r.accounts[e.Account]
+}
+
+// Notifier 알림 라우터 (프로세스당 1개, 설정을 다시 읽으면 Configure로 대상 교체)
+type Notifier struct {
+	mu      sync.Mutex
+	routes  []*route
+	timeout time.Duration
+	client  *http.Client
+
+	since time.Time                  // 일일 요약 집계 시작
+	tally map[string]*AccountSummary // 계정별 집계
+
+	pending sync.WaitGroup // 전송 중인 알림
+}
+
+// New 대상 없는 알림 라우터 (Configure 전에는 집계만 함)
+func New() *Notifier {
+	return &Notifier{
+		timeout: config.DefaultNotifyTimeout,
+		client:  &http.Client{},
+		since:   time.Now(),
+		tally:   map[string]*AccountSummary{},
+	}
+}
+
+// Configure 설정으로 알림 대상 교체 (nil = 대상 없음)
+// 이름과 rate_limit이 같은 대상은 전송 기록을 이어받는다.
+func (n *Notifier) Configure(cfg *config.NotifyConfig) error {
+	timeout, err := cfg.TimeoutDuration()
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	old := map[string]*route{}
+	for _, r := range n.routes {
+		old[r.name] = r
+	}
+
+	var routes []*route
+	if cfg != nil {
+		for _, sc := range cfg.Sinks {
+			sink, err := NewSink(sc, n.client)
+			if err != nil {
+				return fmt.Errorf("알림 대상 %s: %w", sc.DisplayName(), err)
+			}
+			r := &route{name: sc.DisplayName(), rateLimit: sc.RateLimit, sink: sink}
+			if len(sc.Events) > 0 {
+				r.events = map[Kind]bool{}
+				for _, e := range sc.Events {
+					r.events[Kind(e)] = true
+				}
+			}
+			if len(sc.Accounts) > 0 {
+				r.accounts = map[string]bool{}
+				for _, a := range sc.Accounts {
+					r.accounts[a] = true
+				}
+			}
+			count, per, err := sc.RateLimitValue()
+			if err != nil {
+				return fmt.Errorf("알림 대상 %s: %w", r.name, err)
+			}
+			if prev, ok := old[r.name]; ok && prev.rateLimit == r.rateLimit {
+				r.limit = prev.limit
+			} else if count > 0 {
+				r.limit = newLimiter(count, per)
+			}
+			routes = append(routes, r)
+		}
+	}
+	n.routes, n.timeout = routes, timeout
+	return nil
+}
+
+// Sinks 설정된 대상 이름
+func (n *Notifier) Sinks() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	names := make([]string, len(n.routes))
+	for i, r := range n.routes {
+		names[i] = r.name
+	}
+	return names
+}
+
+// Notify 이벤트를 일일 요약에 집계하고 맞는 대상에 백그라운드로 전송 (실패는 로그만)
+// ctx가 취소돼도(종료 중) 전송은 notify.timeout까지 계속한다.
+func (n *Notifier) Notify(ctx context.Context, e Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	n.record(e)
+
+	n.pending.Add(1)
+	go func() {
+		defer n.pending.Done()
+		ctx := context.WithoutCancel(ctx)
+		for _, res := range n.Send(ctx, e, "") {
+			log := logging.From(ctx).With("sink", res.Sink, "event", string(e.Kind))
+			switch {
+			case res.Suppressed:
+				log.Debug("🔕 알림 생략 (rate_limit)")
+			case res.Err != nil:
+				log.Warn("⚠️ 알림 전송 실패", "err", res.Err)
+			default:
+				log.Debug("📨 알림 전송")
+			}
+		}
+	}()
+}
+
+// Send 이벤트를 맞는 대상에 바로 전송 (only가 있으면 그 이름의 대상만, 라우팅 규칙은 그대로 적용)
+func (n *Notifier) Send(ctx context.Context, e Event, only string) []Result {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	n.mu.Lock()
+	routes, timeout := n.routes, n.timeout
+	n.mu.Unlock()
+
+	var results []Result
+	for _, r := range routes {
+		if (only != "" && r.name != only) || !r.match(e) {
+			continue
+		}
+		ev := e
+		if ev.Kind == KindDailySummary {
+			ev.Summary = ev.Summary.forAccounts(r.accounts)
+		} else if r.limit != nil {
+			ok, dropped := r.limit.allow(time.Now())
+			if !ok {
+				results = append(results, Result{Sink: r.name, Suppressed: true})
+				continue
+			}
+			ev.Suppressed = dropped
+		}
+
+		sendCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := r.sink.Send(sendCtx, ev)
+		cancel()
+		results = append(results, Result{Sink: r.name, Err: err})
+	}
+	return results
+}
+
+// Wait 백그라운드 전송이 끝날 때까지 대기 (종료 직전 호출)
+func (n *Notifier) Wait() {
+	n.pending.Wait()
+}
+
+// record 일일 요약 집계
+func (n *Notifier) record(e Event) {
+	if e.Account == "" {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	a, ok := n.tally[e.Account]
+	if !ok {
+		a = &AccountSummary{Account: e.Account}
+		n.tally[e.Account] = a
+	}
+	switch e.Kind {
+	case KindPublishSuccess:
+		if e.Draft {
+			a.Drafts++
+		} else {
+			a.Published++
+		}
+	case KindPublishFailure:
+		a.Failed++
+	case KindLoginFailure, KindCaptcha:
+		a.LoginFailures++
+	}
+}
+
+// Summary 마지막 요약 이후의 집계로 일일 요약 이벤트를 만들고 집계 초기화
+func (n *Notifier) Summary(now time.Time) Event {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	s := &Summary{Since: n.since, Until: now}
+	for _, a := range n.tally {
+		s.Accounts = append(s.Accounts, *a)
+	}
+	sort.Slice(s.Accounts, func(i, j int) bool { return s.Accounts[i].Account < s.Accounts[j].Account })
+	n.since, n.tally = now, map[string]*AccountSummary{}
+	return Event{Kind: KindDailySummary, Time: now, Summary: s}
+}
@@ -0,0 +1,405 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+	"github.com/Song-wh/tistory-bot/internal/notify/fake"
+)
+
+var testTime = time.Date(2026, 10, 14, 22, 0, 0, 0, time.UTC)
+
+// newTestNotifier 설정한 대상으로 바로 보내는 라우터
+func newTestNotifier(t *testing.T, sinks ...config.NotifySink) *Notifier {
+	t.Helper()
+	n := New()
+	if err := n.Configure(&config.NotifyConfig{Sinks: sinks}); err != nil {
+		t.Fatalf("Configure: %v", err)
+	}
+	return n
+}
+
+// sentTo 결과 중 실제로 보낸 대상 이름 (rate_limit으로 건너뛴 대상은 "-" 접두)
+func sentTo(t *testing.T, results []Result) string {
+	t.Helper()
+	var names []string
+	for _, res := range results {
+		switch {
+		case res.Err != nil:
+			t.Errorf("%s: %v", res.Sink, res.Err)
+		case res.Suppressed:
+			names = append(names, "-"+res.Sink)
+		default:
+			names = append(names, res.Sink)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// TestRouting 대상별 events / accounts 조건과 --sink 지정
+func TestRouting(t *testing.T) {
+	srv := fake.NewServer()
+	t.Cleanup(srv.Close)
+	n := newTestNotifier(t,
+		config.NotifySink{Name: "all", Type: "webhook", URL: srv.URL + "/all"},
+		config.NotifySink{Name: "failures", Type: "slack", URL: srv.URL + "/failures", Events: []string{config.EventPublishFailure, config.EventCaptcha}},
+		config.NotifySink{Name: "golf", Type: "discord", URL: srv.URL + "/golf", Accounts: []string{"golf"}},
+		config.NotifySink{Name: "golf-summary", Type: "slack", URL: srv.URL + "/golf-summary", Events: []string{config.EventDailySummary}, Accounts: []string{"golf"}},
+	)
+
+	tests := []struct {
+		name  string
+		event Event
+		only  string
+		want  string
+	}{
+		{"성공은 전체 대상만", Event{Kind: KindPublishSuccess, Account: "main"}, "", "all"},
+		{"실패는 실패 대상에도", Event{Kind: KindPublishFailure, Account: "main"}, "", "all,failures"},
+		{"계정 조건", Event{Kind: KindPublishSuccess, Account: "golf"}, "", "all,golf"},
+		{"캡챠 + 계정", Event{Kind: KindCaptcha, Account: "golf"}, "", "all,failures,golf"},
+		{"로그인 실패는 이벤트 조건에서 제외", Event{Kind: KindLoginFailure, Account: "main"}, "", "all"},
+		{"일일 요약은 계정 조건 없이", Event{Kind: KindDailySummary, Summary: &Summary{}}, "", "all,golf,golf-summary"},
+		{"대상 지정", Event{Kind: KindPublishFailure, Account: "main"}, "failures", "failures"},
+		{"지정한 대상이 조건에 안 맞음", Event{Kind: KindPublishSuccess, Account: "main"}, "golf", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sentTo(t, n.Send(context.Background(), tt.event, tt.only)); got != tt.want {
+				t.Errorf("sent to %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRoutingSummaryAccounts 계정 조건이 있는 대상에는 해당 계정 집계만 보냄
+func TestRoutingSummaryAccounts(t *testing.T) {
+	srv := fake.NewServer()
+	t.Cleanup(srv.Close)
+	n := newTestNotifier(t, config.NotifySink{Name: "golf", Type: "webhook", URL: srv.URL, Accounts: []string{"golf"}})
+
+	n.Send(context.Background(), Event{Kind: KindDailySummary, Time: testTime, Summary: &Summary{
+		Since:    testTime.Add(-24 * time.Hour),
+		Until:    testTime,
+		Accounts: []AccountSummary{{Account: "golf", Published: 2}, {Account: "main", Published: 5}},
+	}}, "")
+	reqs := srv.Requests()
+	if len(reqs) != 1 {
+		t.Fatalf("requests = %d, want 1", len(reqs))
+	}
+	var got Event
+	if err := json.Unmarshal(reqs[0].Body, &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Summary.Accounts) != 1 || got.Summary.Accounts[0].Account != "golf" {
+		t.Errorf("summary accounts = %+v, want golf only", got.Summary.Accounts)
+	}
+}
+
+// TestLimiter 슬라이딩 윈도 전송 제한과 건너뛴 수
+func TestLimiter(t *testing.T) {
+	tests := []struct {
+		at          time.Duration // 첫 전송 이후
+		wantOK      bool
+		wantDropped int
+	}{
+		{0, true, 0},
+		{10 * time.Minute, true, 0},
+		{20 * time.Minute, false, 0},
+		{30 * time.Minute, false, 0},
+		{time.Hour, true, 2}, // 첫 전송이 윈도 밖으로
+		{61 * time.Minute, false, 0},
+		{70 * time.Minute, true, 1},
+		{4 * time.Hour, true, 0},
+	}
+	l := newLimiter(2, time.Hour)
+	for _, tt := range tests {
+		ok, dropped := l.allow(testTime.Add(tt.at))
+		if ok != tt.wantOK || dropped != tt.wantDropped {
+			t.Errorf("allow(+%s) = %v, %d, want %v, %d", tt.at, ok, dropped, tt.wantOK, tt.wantDropped)
+		}
+	}
+}
+
+// TestRateLimit rate_limit을 넘는 알림은 건너뛰고, 설정을 다시 읽어도 같은 대상이면 기록 유지
+func TestRateLimit(t *testing.T) {
+	srv := fake.NewServer()
+	t.Cleanup(srv.Close)
+	limited := config.NotifySink{Name: "ops", Type: "slack", URL: srv.URL + "/ops", RateLimit: "2/1h"}
+	free := config.NotifySink{Name: "log", Type: "webhook", URL: srv.URL + "/log"}
+	n := newTestNotifier(t, limited, free)
+
+	failure := Event{Kind: KindPublishFailure, Account: "main", Error: "시간 초과"}
+	want := []string{"ops,log", "ops,log", "-ops,log"}
+	for i, w := range want {
+		if got := sentTo(t, n.Send(context.Background(), failure, "")); got != w {
+			t.Errorf("send %d: sent to %q, want %q", i+1, got, w)
+		}
+	}
+
+	// 일일 요약은 제한하지 않음
+	if got := sentTo(t, n.Send(context.Background(), Event{Kind: KindDailySummary}, "")); got != "ops,log" {
+		t.Errorf("daily summary sent to %q, want ops,log", got)
+	}
+
+	// 같은 이름·rate_limit이면 전송 기록 유지, rate_limit이 바뀌면 새로 시작
+	if err := n.Configure(&config.NotifyConfig{Sinks: []config.NotifySink{limited, free}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := sentTo(t, n.Send(context.Background(), failure, "ops")); got != "-ops" {
+		t.Errorf("after reload: sent to %q, want -ops", got)
+	}
+	limited.RateLimit = "3/1h"
+	if err := n.Configure(&config.NotifyConfig{Sinks: []config.NotifySink{limited, free}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := sentTo(t, n.Send(context.Background(), failure, "ops")); got != "ops" {
+		t.Errorf("after rate_limit change: sent to %q, want ops", got)
+	}
+}
+
+// TestEventText 이벤트별 메시지 (첫 줄은 메일 제목)
+func TestEventText(t *testing.T) {
+	tests := []struct {
+		name  string
+		event Event
+		want  string
+	}{
+		{"발행", Event{Kind: KindPublishSuccess, Account: "main", Category: "fortune", Title: "오늘의 운세", URL: "https://main.tistory.com/12"},
+			"✅ [main/fortune] 포스팅 완료: 오늘의 운세\nhttps://main.tistory.com/12"},
+		{"임시저장", Event{Kind: KindPublishSuccess, Account: "main", Category: "fortune", Title: "오늘의 운세", Draft: true},
+			"💾 [main/fortune] 임시저장 완료: 오늘의 운세"},
+		{"발행 실패 + 힌트", Event{Kind: KindPublishFailure, Account: "main", Category: "golf", Title: "골프 팁", Error: "confirm 단계: 시간 초과", Hint: "관리 페이지에서 확인"},
+			"❌ [main/golf] 포스팅 실패: 골프 팁\nconfirm 단계: 시간 초과\n💡 관리 페이지에서 확인"},
+		{"제목 전 실패", Event{Kind: KindPublishFailure, Account: "main", Category: "golf", Error: "수집 실패"},
+			"❌ [main/golf] 포스팅 실패\n수집 실패"},
+		{"로그인 실패", Event{Kind: KindLoginFailure, Account: "main", Error: "비밀번호 오류"},
+			"🔐 [main] 로그인 실패\n비밀번호 오류"},
+		{"캡챠", Event{Kind: KindCaptcha, Account: "main", Error: "captcha"},
+			"🧩 [main] 캡챠 확인 필요 (login 명령으로 직접 로그인하세요)\ncaptcha"},
+		{"건너뛴 알림 수", Event{Kind: KindLoginFailure, Account: "main", Error: "x", Suppressed: 3},
+			"🔐 [main] 로그인 실패\nx\n(직전 알림 3건은 rate_limit으로 생략됨)"},
+		{"일일 요약", Event{Kind: KindDailySummary, Summary: &Summary{
+			Since: testTime.Add(-24 * time.Hour), Until: testTime,
+			Accounts: []AccountSummary{{Account: "golf", Published: 2, Drafts: 1, Failed: 1, LoginFailures: 1}, {Account: "main", Published: 3}},
+		}}, "📊 일일 요약 (10/13 22:00 ~ 10/14 22:00)\n• golf: 발행 2 / 임시저장 1 / 실패 1 / 로그인 실패 1\n• main: 발행 3 / 실패 0"},
+		{"빈 일일 요약", Event{Kind: KindDailySummary, Summary: &Summary{Since: testTime.Add(-24 * time.Hour), Until: testTime}},
+			"📊 일일 요약 (10/13 22:00 ~ 10/14 22:00)\n실행된 포스팅 없음"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.Text(); got != tt.want {
+				t.Errorf("Text() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSinkPayload 대상 종류별 요청 경로·본문
+func TestSinkPayload(t *testing.T) {
+	event := Event{Kind: KindPublishFailure, Time: testTime, Account: "main", Category: "golf", Title: "골프 팁", Error: "시간 초과"}
+	long := event
+	long.Error = strings.Repeat("가", 3000)
+
+	tests := []struct {
+		name     string
+		sink     config.NotifySink
+		event    Event
+		wantPath string
+		check    func(t *testing.T, req fake.Request)
+	}{
+		{"webhook", config.NotifySink{Type: "webhook", URL: "/hook", Headers: map[string]string{"Authorization": "Bearer abc"}}, event, "/hook",
+			func(t *testing.T, req fake.Request) {
+				if got := req.Header.Get("Authorization"); got != "Bearer abc" {
+					t.Errorf("Authorization = %q", got)
+				}
+				var got struct {
+					Event
+					Text string `json:"text"`
+				}
+				if err := json.Unmarshal(req.Body, &got); err != nil {
+					t.Fatal(err)
+				}
+				if got.Kind != KindPublishFailure || got.Account != "main" || got.Title != "골프 팁" || got.Error != "시간 초과" || !got.Time.Equal(testTime) {
+					t.Errorf("event = %+v", got.Event)
+				}
+				if got.Text != event.Text() {
+					t.Errorf("text = %q", got.Text)
+				}
+			}},
+		{"slack", config.NotifySink{Type: "slack", URL: "/slack"}, event, "/slack",
+			func(t *testing.T, req fake.Request) {
+				if body := string(req.Body); !strings.HasPrefix(body, `{"text":`) {
+					t.Errorf("body = %s", body)
+				}
+				if req.Text != event.Text() {
+					t.Errorf("text = %q", req.Text)
+				}
+			}},
+		{"discord 길이 제한", config.NotifySink{Type: "discord", URL: "/discord"}, long, "/discord",
+			func(t *testing.T, req fake.Request) {
+				if body := string(req.Body); !strings.HasPrefix(body, `{"content":`) {
+					t.Errorf("body = %.40s", body)
+				}
+				if n := utf8.RuneCountInString(req.Text); n != discordMaxLen || !strings.HasSuffix(req.Text, "…") {
+					t.Errorf("content length = %d, want %d ending with …", n, discordMaxLen)
+				}
+			}},
+		{"telegram", config.NotifySink{Type: "telegram", BotToken: "123:abc", ChatID: "-100"}, event, "/bot123:abc/sendMessage",
+			func(t *testing.T, req fake.Request) {
+				var got struct {
+					ChatID  string `json:"chat_id"`
+					Text    string `json:"text"`
+					Preview bool   `json:"disable_web_page_preview"`
+				}
+				if err := json.Unmarshal(req.Body, &got); err != nil {
+					t.Fatal(err)
+				}
+				if got.ChatID != "-100" || got.Text != event.Text() || !got.Preview {
+					t.Errorf("sendMessage = %+v", got)
+				}
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := fake.NewServer()
+			t.Cleanup(srv.Close)
+			sc := tt.sink
+			sc.URL = srv.URL + sc.URL
+			if sc.Type == "telegram" {
+				sc.APIURL = srv.URL + "/"
+			}
+			sink, err := NewSink(sc, &http.Client{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := sink.Send(context.Background(), tt.event); err != nil {
+				t.Fatalf("Send: %v", err)
+			}
+			reqs := srv.Requests()
+			if len(reqs) != 1 {
+				t.Fatalf("requests = %d, want 1", len(reqs))
+			}
+			if reqs[0].Path != tt.wantPath {
+				t.Errorf("path = %q, want %q", reqs[0].Path, tt.wantPath)
+			}
+			tt.check(t, reqs[0])
+		})
+	}
+}
+
+// TestSinkErrors 2xx가 아닌 응답은 에러로 (상태 코드 포함)
+func TestSinkErrors(t *testing.T) {
+	tests := []struct {
+		sink    config.NotifySink
+		status  int
+		wantErr string
+	}{
+		{config.NotifySink{Type: "webhook"}, 500, "HTTP 500"},
+		{config.NotifySink{Type: "slack"}, 404, "HTTP 404"},
+		{config.NotifySink{Type: "telegram", BotToken: "t", ChatID: "1"}, 429, "HTTP 429"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sink.Type, func(t *testing.T) {
+			srv := fake.NewServer()
+			t.Cleanup(srv.Close)
+			sc := tt.sink
+			sc.URL, sc.APIURL = srv.URL, srv.URL
+			sink, err := NewSink(sc, &http.Client{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			srv.FailNext(tt.status)
+			err = sink.Send(context.Background(), Event{Kind: KindCaptcha, Account: "main"})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Send = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestEmailSink SMTP 대역 서버로 보낸 메일의 헤더·본문
+func TestEmailSink(t *testing.T) {
+	srv, err := fake.NewSMTPServer("127.0.0.1:0", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { srv.Close() })
+	host, port, _ := net.SplitHostPort(srv.Addr)
+	portNum, _ := strconv.Atoi(port)
+
+	sink, err := NewSink(config.NotifySink{Type: "email", SMTP: &config.SMTPConfig{
+		Host: host, Port: portNum, From: "bot@example.com", To: []string{"a@example.com", "b@example.com"},
+	}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := Event{Kind: KindPublishFailure, Time: testTime, Account: "main", Title: "골프 팁", Error: "시간 초과\n두 번째 줄"}
+	if err := sink.Send(context.Background(), event); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	mails := srv.Mails()
+	if len(mails) != 1 {
+		t.Fatalf("mails = %d, want 1", len(mails))
+	}
+	m := mails[0]
+	if m.From != "bot@example.com" || strings.Join(m.To, ",") != "a@example.com,b@example.com" {
+		t.Errorf("envelope = %s → %v", m.From, m.To)
+	}
+	header, body, _ := strings.Cut(m.Data, "\r\n\r\n")
+	var subject string
+	for _, line := range strings.Split(header, "\r\n") {
+		if v, ok := strings.CutPrefix(line, "Subject: "); ok {
+			subject, err = new(mime.WordDecoder).DecodeHeader(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if want := "[tistory-bot] ❌ [main] 포스팅 실패: 골프 팁"; subject != want {
+		t.Errorf("subject = %q, want %q", subject, want)
+	}
+	if !strings.Contains(header, "Content-Type: text/plain; charset=UTF-8") {
+		t.Errorf("header = %q", header)
+	}
+	if want := "시간 초과\r\n두 번째 줄\r\n"; body != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+// TestSummary 알림 이벤트 집계 → 일일 요약 (요약 후 초기화)
+func TestSummary(t *testing.T) {
+	n := New()
+	for _, e := range []Event{
+		{Kind: KindPublishSuccess, Account: "main"},
+		{Kind: KindPublishSuccess, Account: "main", Draft: true},
+		{Kind: KindPublishFailure, Account: "golf"},
+		{Kind: KindCaptcha, Account: "golf"},
+		{Kind: KindLoginFailure, Account: "golf"},
+		{Kind: KindDailySummary},
+	} {
+		n.Notify(context.Background(), e)
+	}
+	n.Wait()
+
+	got := n.Summary(testTime).Summary.Accounts
+	want := []AccountSummary{
+		{Account: "golf", Failed: 1, LoginFailures: 2},
+		{Account: "main", Published: 1, Drafts: 1},
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("summary = %+v, want %+v", got, want)
+	}
+	if next := n.Summary(testTime.Add(time.Hour)).Summary; len(next.Accounts) != 0 || !next.Since.Equal(testTime) {
+		t.Errorf("next summary = %+v, want empty since %s", next, testTime)
+	}
+}
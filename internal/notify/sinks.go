@@ -0,0 +1,145 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+)
+
+// DefaultTelegramAPI telegram api_url 미지정 시 Bot API 주소
+const DefaultTelegramAPI = "https://api.telegram.org"
+
+// 메신저별 메시지 길이 제한 (넘으면 잘라서 보냄)
+const (
+	discordMaxLen  = 2000
+	telegramMaxLen = 4096
+)
+
+// NewSink 설정으로 알림 대상 생성
+func NewSink(cfg config.NotifySink, client *http.Client) (Sink, error) {
+	switch cfg.Type {
+	case "webhook":
+		return &webhookSink{url: cfg.URL, headers: cfg.Headers, client: client}, nil
+	case "slack":
+		return &chatSink{url: cfg.URL, field: "text", client: client}, nil
+	case "discord":
+		return &chatSink{url: cfg.URL, field: "content", maxLen: discordMaxLen, client: client}, nil
+	case "telegram":
+		api := cfg.APIURL
+		if api == "" {
+			api = DefaultTelegramAPI
+		}
+		return &telegramSink{api: strings.TrimRight(api, "/"), token: cfg.BotToken, chatID: cfg.ChatID, client: client}, nil
+	case "email":
+		if cfg.SMTP == nil {
+			return nil, fmt.Errorf("smtp 설정 없음")
+		}
+		return &emailSink{smtp: *cfg.SMTP}, nil
+	}
+	return nil, fmt.Errorf("알 수 없는 알림 종류: %s", cfg.Type)
+}
+
+// webhookSink 이벤트를 그대로 JSON으로 POST (메시지는 text 필드)
+type webhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (s *webhookSink) Send(ctx context.Context, e Event) error {
+	payload := struct {
+		Event
+		Text string `json:"text"`
+	}{e, e.Text()}
+	_, err := postJSON(ctx, s.client, s.url, s.headers, payload)
+	return err
+}
+
+// chatSink Slack 호환 수신 웹훅 ({"text": ...}), Discord 웹훅 ({"content": ...})
+type chatSink struct {
+	url    string
+	field  string
+	maxLen int // 0 = 제한 없음
+	client *http.Client
+}
+
+func (s *chatSink) Send(ctx context.Context, e Event) error {
+	_, err := postJSON(ctx, s.client, s.url, nil, map[string]string{s.field: truncate(e.Text(), s.maxLen)})
+	return err
+}
+
+// telegramSink Telegram Bot API sendMessage
+type telegramSink struct {
+	api    string
+	token  string
+	chatID string
+	client *http.Client
+}
+
+func (s *telegramSink) Send(ctx context.Context, e Event) error {
+	body, err := postJSON(ctx, s.client, s.api+"/bot"+s.token+"/sendMessage", nil, map[string]interface{}{
+		"chat_id":                  s.chatID,
+		"text":                     truncate(e.Text(), telegramMaxLen),
+		"disable_web_page_preview": true,
+	})
+	if err != nil {
+		return err
+	}
+	var resp struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("telegram 응답 파싱 실패: %w", err)
+	}
+	if !resp.OK {
+		return fmt.Errorf("telegram 전송 실패: %s", resp.Description)
+	}
+	return nil
+}
+
+// postJSON payload를 JSON으로 POST하고 2xx 응답 본문 반환
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, payload interface{}) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return body, fmt.Errorf("HTTP %d: %s", resp.StatusCode, truncate(strings.TrimSpace(string(body)), 200))
+	}
+	return body, nil
+}
+
+// truncate 최대 n글자로 자르기 (n이 0이면 그대로)
+func truncate(s string, n int) string {
+	if n <= 0 || utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	r := []rune(s)
+	return string(r[:n-1]) + "…"
+}
@@ -0,0 +1,202 @@
+package preview
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Meta 미리보기 메타데이터 (meta.json)
+type Meta struct {
+	Account        string    `json:"account"`
+	Category       string    `json:"category"`        // 수집기 카테고리 키 (예: crypto)
+	PostCategory   string    `json:"post_category"`   // 수집기가 지정한 카테고리 (예: 주식/코인)
+	MappedCategory string    `json:"mapped_category"` // 계정 매핑 결과 티스토리 카테고리 (빈 값 = 기본 카테고리)
+	BlogName       string    `json:"blog_name"`
+	Title          string    `json:"title"`
+	Tags           []string  `json:"tags"`
+	SourceIDs      []string  `json:"source_ids,omitempty"`
+	Thumbnail      string    `json:"thumbnail,omitempty"` // 미리보기 디렉토리 기준 상대 경로
+	GeneratedAt    time.Time `json:"generated_at"`
+}
+
+// Entry 저장된 미리보기 1건
+type Entry struct {
+	Meta
+	Dir string `json:"dir"` // 루트 디렉토리 기준 상대 경로 (슬래시 구분)
+}
+
+// 미리보기 디렉토리 파일 이름
+const (
+	metaFile    = "meta.json"
+	pageFile    = "index.html"
+	contentFile = "content.html"
+)
+
+// Write 미리보기 저장 ({root}/{account}/{category}-{시각}/)
+// 본문 원본(content.html), 티스토리 스킨 폭을 흉내낸 페이지(index.html), 메타데이터(meta.json)를 기록한다.
+// thumbnailPath가 있으면 미리보기 디렉토리로 복사한다.
+func Write(root string, meta Meta, content, thumbnailPath string) (string, error) {
+	if meta.GeneratedAt.IsZero() {
+		meta.GeneratedAt = time.Now()
+	}
+
+	dir := filepath.Join(root, sanitize(meta.Account),
+		fmt.Sprintf("%s-%s", sanitize(meta.Category), meta.GeneratedAt.Format("20060102-150405")))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("미리보기 디렉토리 생성 실패: %w", err)
+	}
+
+	if thumbnailPath != "" {
+		name := "thumbnail" + filepath.Ext(thumbnailPath)
+		if err := copyFile(thumbnailPath, filepath.Join(dir, name)); err != nil {
+			return "", fmt.Errorf("썸네일 복사 실패: %w", err)
+		}
+		meta.Thumbnail = name
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, contentFile), []byte(content), 0644); err != nil {
+		return "", fmt.Errorf("본문 저장 실패: %w", err)
+	}
+
+	f, err := os.Create(filepath.Join(dir, pageFile))
+	if err != nil {
+		return "", fmt.Errorf("미리보기 페이지 생성 실패: %w", err)
+	}
+	defer f.Close()
+
+	if err := pageTmpl.Execute(f, struct {
+		Meta
+		Content template.HTML
+	}{meta, template.HTML(content)}); err != nil {
+		return "", fmt.Errorf("미리보기 페이지 렌더링 실패: %w", err)
+	}
+
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, metaFile), data, 0644); err != nil {
+		return "", fmt.Errorf("메타데이터 저장 실패: %w", err)
+	}
+
+	return dir, nil
+}
+
+// List 저장된 미리보기 목록 (최신순)
+func List(root string) ([]Entry, error) {
+	var entries []Entry
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != metaFile {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		var meta Meta
+		if err := json.Unmarshal(data, &meta); err != nil {
+			return nil // 깨진 메타데이터는 무시
+		}
+
+		rel, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return nil
+		}
+		entries = append(entries, Entry{Meta: meta, Dir: filepath.ToSlash(rel)})
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].GeneratedAt.After(entries[j].GeneratedAt)
+	})
+	return entries, nil
+}
+
+// copyFile 파일 복사
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// sanitize 경로에 쓸 수 없는 문자 치환
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ':
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+// pageTmpl 티스토리 스킨(본문 폭 860px)을 흉내낸 미리보기 페이지
+var pageTmpl = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="ko">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { margin: 0; background: #f2f2f2; font-family: -apple-system, BlinkMacSystemFont, 'Malgun Gothic', sans-serif; color: #333; }
+.blog-header { background: #fff; border-bottom: 1px solid #e5e5e5; padding: 18px 0; text-align: center; font-weight: 700; }
+.preview-info { max-width: 860px; margin: 16px auto 0; padding: 12px 16px; background: #fffbe6; border: 1px solid #ffe58f; border-radius: 6px; font-size: 13px; line-height: 1.7; }
+.preview-info code { background: #fff; padding: 1px 4px; border-radius: 3px; }
+#content { max-width: 860px; margin: 16px auto 60px; background: #fff; padding: 40px 50px; box-sizing: border-box; }
+.post-category { color: #999; font-size: 13px; }
+.post-title { font-size: 28px; margin: 8px 0 16px; line-height: 1.4; }
+.post-meta { color: #999; font-size: 13px; padding-bottom: 20px; border-bottom: 1px solid #eee; margin-bottom: 30px; }
+.cover img { width: 100%; border-radius: 6px; margin-bottom: 30px; }
+.contents_style { font-size: 16px; line-height: 1.8; word-break: break-all; }
+.contents_style img { max-width: 100%; height: auto; }
+.tags { margin-top: 40px; padding-top: 20px; border-top: 1px solid #eee; }
+.tags span { display: inline-block; margin: 0 6px 6px 0; padding: 4px 10px; background: #f5f5f5; border-radius: 14px; font-size: 13px; color: #666; }
+</style>
+</head>
+<body>
+<div class="blog-header">{{if .BlogName}}{{.BlogName}}.tistory.com{{else}}{{.Account}}{{end}}</div>
+<div class="preview-info">
+🔍 <b>미리보기 (발행 안 됨)</b><br>
+계정: <code>{{.Account}}</code> · 수집기: <code>{{.Category}}</code> · 포스트 카테고리: <code>{{.PostCategory}}</code> →
+티스토리 카테고리: {{if .MappedCategory}}<code>{{.MappedCategory}}</code>{{else}}<i>미설정 (기본 카테고리)</i>{{end}}<br>
+생성: {{.GeneratedAt.Format "2006-01-02 15:04:05"}}
+</div>
+<div id="content">
+<div class="post-category">{{if .MappedCategory}}{{.MappedCategory}}{{else}}카테고리 없음{{end}}</div>
+<h1 class="post-title">{{.Title}}</h1>
+<div class="post-meta">{{.GeneratedAt.Format "2006. 1. 2. 15:04"}}</div>
+{{if .Thumbnail}}<div class="cover"><img src="{{.Thumbnail}}" alt="썸네일"></div>{{end}}
+<div class="tt_article_useless_p_margin contents_style">
+{{.Content}}
+</div>
+{{if .Tags}}<div class="tags">{{range .Tags}}<span>#{{.}}</span>{{end}}</div>{{end}}
+</div>
+</body>
+</html>
+`))
@@ -0,0 +1,76 @@
+package preview
+
+import (
+	"html/template"
+	"net/http"
+)
+
+// Handler 미리보기 갤러리 HTTP 핸들러 (/ = 목록, /p/{dir}/ = 개별 미리보기)
+func Handler(root string) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/p/", http.StripPrefix("/p/", http.FileServer(http.Dir(root))))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		entries, err := List(root)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := galleryTmpl.Execute(w, struct {
+			Root    string
+			Entries []Entry
+		}{root, entries}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+	return mux
+}
+
+// galleryTmpl 미리보기 목록 페이지
+var galleryTmpl = template.Must(template.New("gallery").Parse(`<!DOCTYPE html>
+<html lang="ko">
+<head>
+<meta charset="utf-8">
+<title>tistory-bot 미리보기</title>
+<style>
+body { margin: 0; padding: 30px; background: #f2f2f2; font-family: -apple-system, BlinkMacSystemFont, 'Malgun Gothic', sans-serif; }
+h1 { font-size: 22px; margin: 0 0 6px; }
+.root { color: #888; font-size: 13px; margin-bottom: 24px; }
+.grid { display: grid; grid-template-columns: repeat(auto-fill, minmax(280px, 1fr)); gap: 20px; }
+.card { background: #fff; border-radius: 10px; overflow: hidden; text-decoration: none; color: #222; box-shadow: 0 1px 3px rgba(0,0,0,0.08); }
+.card:hover { box-shadow: 0 6px 18px rgba(0,0,0,0.12); }
+.thumb { width: 100%; aspect-ratio: 1200 / 630; object-fit: cover; background: #ddd; display: block; }
+.info { padding: 12px 14px; }
+.title { font-weight: 600; font-size: 15px; line-height: 1.4; margin-bottom: 6px; }
+.meta { font-size: 12px; color: #888; }
+.empty { color: #888; }
+</style>
+</head>
+<body>
+<h1>🔍 tistory-bot 미리보기 갤러리</h1>
+<div class="root">{{.Root}} · {{len .Entries}}건</div>
+{{if .Entries}}
+<div class="grid">
+{{range .Entries}}
+<a class="card" href="/p/{{.Dir}}/" target="_blank">
+{{if .Thumbnail}}<img class="thumb" src="/p/{{.Dir}}/{{.Thumbnail}}" alt="">{{else}}<div class="thumb"></div>{{end}}
+<div class="info">
+<div class="title">{{.Title}}</div>
+<div class="meta">{{.Account}} · {{.Category}} → {{if .MappedCategory}}{{.MappedCategory}}{{else}}기본 카테고리{{end}}</div>
+<div class="meta">{{.GeneratedAt.Format "2006-01-02 15:04:05"}}</div>
+</div>
+</a>
+{{end}}
+</div>
+{{else}}
+<p class="empty">미리보기가 없습니다. <code>tistory-bot post [category] --dry-run</code>으로 생성하세요.</p>
+{{end}}
+</body>
+</html>
+`))
@@ -0,0 +1,418 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/config"
+)
+
+// Status 작업 상태
+type Status string
+
+const (
+	StatusPending Status = "pending" // 실행 대기 (재시도 대기 포함)
+	StatusRunning Status = "running" // 실행 중
+	StatusDone    Status = "done"    // 완료 (건너뜀 포함)
+	StatusFailed  Status = "failed"  // 최대 시도 횟수 초과 또는 재시도 불가 에러
+)
+
+// Job 스케줄 작업 1건
+type Job struct {
+	ID          string                `json:"id"`
+	Account     string                `json:"account"`
+	Category    string                `json:"category"`
+	Publish     *config.PublishConfig `json:"publish,omitempty"` // 작업별 발행 방식
+	ScheduledAt time.Time             `json:"scheduled_at"`      // cron 트리거 시각 (중복 등록 방지 키)
+	DueAt       time.Time             `json:"due_at"`            // 실행 예정 시각 (랜덤 딜레이/재시도 대기 반영)
+	Attempts    int                   `json:"attempts"`
+	Status      Status                `json:"status"`
+	LastError   string                `json:"last_error,omitempty"`
+	CatchUp     bool                  `json:"catch_up,omitempty"` // 재시작 시 놓친 작업으로 등록됨
+	Title       string                `json:"title,omitempty"`    // 발행을 시작한 글 제목 (발행 여부 확인용)
+	Baseline    int                   `json:"baseline,omitempty"` // 발행 직전 같은 제목 글 중 가장 큰 글 ID (이보다 새 글만 이 작업의 글로 인정, -1 = 모름)
+	Verify      bool                  `json:"verify,omitempty"`   // 발행 도중 중단됨 (다시 발행하기 전에 발행 여부 확인)
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// RetryPolicy 재시도 정책 (지수 백오프)
+type RetryPolicy struct {
+	MaxAttempts int           // 최대 시도 횟수 (첫 실행 포함)
+	BaseDelay   time.Duration // 첫 재시도 대기
+	MaxDelay    time.Duration // 대기 상한
+}
+
+// Backoff attempt번째 실패 후 대기 시간 (base, 2base, 4base ... max)
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+// permanentError 재시도해도 소용없는 에러
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 재시도하지 않을 에러로 표시 (설정 오류, 중복 발행 위험 등)
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent Permanent로 표시된 에러인지 확인
+func IsPermanent(err error) bool {
+	var pe *permanentError
+	return errors.As(err, &pe)
+}
+
+// state 큐 파일 내용
+type state struct {
+	LastTick time.Time `json:"last_tick"` // 스케줄러가 마지막으로 살아있던 시각
+	Jobs     []Job     `json:"jobs"`
+}
+
+// Queue 파일 기반 작업 큐 ({dir}/jobs.json, 변경마다 전체 저장)
+type Queue struct {
+	path   string
+	policy RetryPolicy
+
+	mu     sync.Mutex
+	state  state
+	wakeup chan struct{}
+}
+
+// Open 큐 파일 로드 (없으면 빈 큐)
+func Open(dir string, policy RetryPolicy) (*Queue, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("큐 디렉토리 생성 실패: %w", err)
+	}
+	q := &Queue{
+		path:   filepath.Join(dir, "jobs.json"),
+		policy: policy,
+		wakeup: make(chan struct{}, 1),
+	}
+
+	data, err := os.ReadFile(q.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return q, nil
+		}
+		return nil, fmt.Errorf("큐 파일 읽기 실패: %w", err)
+	}
+	if err := json.Unmarshal(data, &q.state); err != nil {
+		return nil, fmt.Errorf("큐 파일 파싱 실패 (%s): %w", q.path, err)
+	}
+	return q, nil
+}
+
+// save 큐 파일 저장 (임시 파일에 쓴 뒤 교체)
+func (q *Queue) save() error {
+	data, err := json.MarshalIndent(q.state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("큐 직렬화 실패: %w", err)
+	}
+	tmp := q.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("큐 저장 실패: %w", err)
+	}
+	if err := os.Rename(tmp, q.path); err != nil {
+		return fmt.Errorf("큐 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// notify 대기 중인 워커 깨우기
+func (q *Queue) notify() {
+	select {
+	case q.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// Wakeup 작업이 추가되거나 재시도 예약되면 신호를 받는 채널
+func (q *Queue) Wakeup() <-chan struct{} {
+	return q.wakeup
+}
+
+// jobID 계정/카테고리/트리거 시각으로 작업 ID 생성
+func jobID(account, category string, scheduledAt time.Time) string {
+	return fmt.Sprintf("%s/%s/%s", account, category, scheduledAt.UTC().Format("20060102T150405Z"))
+}
+
+// find ID로 작업 인덱스 조회 (없으면 -1)
+func (q *Queue) find(id string) int {
+	for i := range q.state.Jobs {
+		if q.state.Jobs[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// Enqueue 작업 등록 (같은 계정/카테고리/트리거 시각 작업이 이미 있으면 기존 작업 반환, added=false)
+func (q *Queue) Enqueue(job Job, now time.Time) (Job, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job.ID = jobID(job.Account, job.Category, job.ScheduledAt)
+	if i := q.find(job.ID); i >= 0 {
+		return q.state.Jobs[i], false, nil
+	}
+
+	if job.DueAt.IsZero() {
+		job.DueAt = now
+	}
+	job.Status = StatusPending
+	job.Attempts = 0
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	q.state.Jobs = append(q.state.Jobs, job)
+	if err := q.save(); err != nil {
+		q.state.Jobs = q.state.Jobs[:len(q.state.Jobs)-1]
+		return job, false, err
+	}
+	q.notify()
+	return job, true, nil
+}
+
+// Claim 실행 시각이 된 대기 작업 중 가장 이른 것을 실행 중으로 바꿔 반환 (없으면 nil)
+func (q *Queue) Claim(now time.Time) (*Job, error) {
+	return q.ClaimFunc(now, nil)
+}
+
+// ClaimFunc Claim과 같되 accept가 true인 작업만 대상 (계정 워커가 바쁜 작업 제외 등)
+func (q *Queue) ClaimFunc(now time.Time, accept func(Job) bool) (*Job, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	best := -1
+	for i, job := range q.state.Jobs {
+		if job.Status != StatusPending || job.DueAt.After(now) {
+			continue
+		}
+		if accept != nil && !accept(job) {
+			continue
+		}
+		if best < 0 || job.DueAt.Before(q.state.Jobs[best].DueAt) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return nil, nil
+	}
+
+	job := &q.state.Jobs[best]
+	prev := *job
+	job.Status = StatusRunning
+	job.Attempts++
+	job.UpdatedAt = now
+	if err := q.save(); err != nil {
+		*job = prev // 저장 못 한 작업은 다음에 다시 꺼낼 수 있게 대기 상태 유지
+		return nil, err
+	}
+	claimed := *job
+	return &claimed, nil
+}
+
+// Release 꺼낸 작업을 실행하지 않고 대기 상태로 되돌림 (시도 횟수 복구)
+func (q *Queue) Release(id string, now time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	i := q.find(id)
+	if i < 0 {
+		return fmt.Errorf("작업 없음: %s", id)
+	}
+	job := &q.state.Jobs[i]
+	if job.Status != StatusRunning {
+		return nil
+	}
+	job.Status = StatusPending
+	job.Attempts--
+	job.Verify = job.Title != "" // 발행 버튼을 누른 뒤 취소됐을 수 있음
+	job.UpdatedAt = now
+	return q.save()
+}
+
+// Publishing 발행 직전에 글 제목과 같은 제목 기존 글 ID(baseline) 기록
+// 발행 도중 중단/실패하면 다시 실행하기 전에 이 제목으로 baseline보다 새 글이 있는지 확인한다.
+func (q *Queue) Publishing(id, title string, baseline int, now time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	i := q.find(id)
+	if i < 0 {
+		return fmt.Errorf("작업 없음: %s", id)
+	}
+	job := &q.state.Jobs[i]
+	job.Title = title
+	job.Baseline = baseline
+	job.Verify = false
+	job.UpdatedAt = now
+	return q.save()
+}
+
+// NextDue 가장 이른 대기 작업의 실행 예정 시각 (대기 작업이 없으면 ok=false)
+func (q *Queue) NextDue() (time.Time, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var next time.Time
+	ok := false
+	for _, job := range q.state.Jobs {
+		if job.Status != StatusPending {
+			continue
+		}
+		if !ok || job.DueAt.Before(next) {
+			next, ok = job.DueAt, true
+		}
+	}
+	return next, ok
+}
+
+// Complete 작업 완료 처리
+func (q *Queue) Complete(id string, now time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	i := q.find(id)
+	if i < 0 {
+		return fmt.Errorf("작업 없음: %s", id)
+	}
+	q.state.Jobs[i].Status = StatusDone
+	q.state.Jobs[i].LastError = ""
+	q.state.Jobs[i].UpdatedAt = now
+	return q.save()
+}
+
+// Fail 작업 실패 처리. 재시도 가능하면 백오프 후 다시 대기 상태로 돌리고 재시도 시각을 반환한다.
+// 최대 시도 횟수를 넘었거나 Permanent 에러면 failed로 바꾸고 retry=false.
+// 발행을 시작했던 작업은 Verify로 표시해 재시도 전에 발행 여부를 확인하게 한다.
+func (q *Queue) Fail(id string, cause error, now time.Time) (retryAt time.Time, retry bool, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	i := q.find(id)
+	if i < 0 {
+		return time.Time{}, false, fmt.Errorf("작업 없음: %s", id)
+	}
+	job := &q.state.Jobs[i]
+	job.LastError = cause.Error()
+	job.UpdatedAt = now
+
+	if IsPermanent(cause) || job.Attempts >= q.policy.MaxAttempts {
+		job.Status = StatusFailed
+		return time.Time{}, false, q.save()
+	}
+
+	job.Status = StatusPending
+	job.DueAt = now.Add(q.policy.Backoff(job.Attempts))
+	job.Verify = job.Title != "" // 발행 버튼을 누른 뒤 실패했을 수 있음
+	if err := q.save(); err != nil {
+		return time.Time{}, false, err
+	}
+	q.notify()
+	return job.DueAt, true, nil
+}
+
+// Recover 이전 실행에서 실행 중이던 작업 정리 (비정상 종료 복구)
+// window(작업)보다 오래 전에 실행됐어야 할 작업은 놓친 작업과 같이 다시 실행하지 않고 실패로 끝낸다 (0 = 모두).
+// 나머지는 대기 상태로 되돌리되, 발행을 시작했던 작업은 Verify로 표시해 발행 여부를 확인한 뒤 실행하게 한다.
+func (q *Queue) Recover(now time.Time, window func(Job) time.Duration) (requeued, expired []Job, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i := range q.state.Jobs {
+		job := &q.state.Jobs[i]
+		if job.Status != StatusRunning {
+			continue
+		}
+		job.UpdatedAt = now
+		if w := window(*job); w == 0 || now.Sub(job.DueAt) > w {
+			job.Status = StatusFailed
+			job.LastError = "비정상 종료 후 허용 기간이 지나 다시 실행하지 않음"
+			if job.Title != "" {
+				job.LastError += " (발행됐을 수 있음: " + job.Title + ")"
+			}
+			expired = append(expired, *job)
+			continue
+		}
+		job.Status = StatusPending
+		job.DueAt = now
+		job.Verify = job.Title != ""
+		requeued = append(requeued, *job)
+	}
+	if len(requeued) == 0 && len(expired) == 0 {
+		return nil, nil, nil
+	}
+	return requeued, expired, q.save()
+}
+
+// Prune before 이전에 끝난(done/failed) 작업 삭제
+func (q *Queue) Prune(before time.Time) (int, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	kept := q.state.Jobs[:0]
+	removed := 0
+	for _, job := range q.state.Jobs {
+		finished := job.Status == StatusDone || job.Status == StatusFailed
+		if finished && job.UpdatedAt.Before(before) {
+			removed++
+			continue
+		}
+		kept = append(kept, job)
+	}
+	q.state.Jobs = kept
+	if removed == 0 {
+		return 0, nil
+	}
+	return removed, q.save()
+}
+
+// LastTick 스케줄러가 마지막으로 살아있던 시각 (처음 실행이면 zero)
+func (q *Queue) LastTick() time.Time {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.state.LastTick
+}
+
+// Tick 스케줄러 생존 시각 기록 (재시작 시 놓친 작업 계산 기준)
+func (q *Queue) Tick(now time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.state.LastTick = now
+	return q.save()
+}
+
+// List 전체 작업 목록 (실행 예정 순)
+func (q *Queue) List() []Job {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	jobs := make([]Job, len(q.state.Jobs))
+	copy(jobs, q.state.Jobs)
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].DueAt.Before(jobs[j].DueAt) })
+	return jobs
+}
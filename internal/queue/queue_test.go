@@ -0,0 +1,292 @@
+package queue
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var (
+	testPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute}
+	testNow    = time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+)
+
+// openTest 임시 디렉토리 큐
+func openTest(t *testing.T) (*Queue, string) {
+	t.Helper()
+	dir := t.TempDir()
+	q, err := Open(dir, testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q, dir
+}
+
+// enqueueClaim 작업 1건 등록 후 꺼냄
+func enqueueClaim(t *testing.T, q *Queue) *Job {
+	t.Helper()
+	if _, _, err := q.Enqueue(Job{Account: "main", Category: "fortune", ScheduledAt: testNow}, testNow); err != nil {
+		t.Fatal(err)
+	}
+	job, err := q.Claim(testNow)
+	if err != nil || job == nil {
+		t.Fatalf("Claim = %v, %v", job, err)
+	}
+	return job
+}
+
+// get ID로 현재 작업 상태 조회
+func get(t *testing.T, q *Queue, id string) Job {
+	t.Helper()
+	for _, job := range q.List() {
+		if job.ID == id {
+			return job
+		}
+	}
+	t.Fatalf("작업 없음: %s", id)
+	return Job{}
+}
+
+// TestBackoff 시도 횟수별 재시도 대기 (최대 대기에서 멈춤)
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 8 * time.Minute},
+		{5, 10 * time.Minute},
+		{10, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := testPolicy.Backoff(tt.attempt); got != tt.want {
+			t.Errorf("Backoff(%d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}
+
+// TestFail 실패 원인·발행 시작 여부에 따른 재시도/Verify 전환
+func TestFail(t *testing.T) {
+	tests := []struct {
+		name       string
+		title      string // Publishing으로 기록한 제목 (빈 값 = 발행 전 실패)
+		attempts   int    // 실패 시점까지 시도 횟수
+		cause      error
+		wantStatus Status
+		wantRetry  bool
+		wantVerify bool
+	}{
+		{"수집 실패 재시도", "", 1, errors.New("수집 실패"), StatusPending, true, false},
+		{"발행 클릭 후 실패 재시도", "오늘의 운세", 1, errors.New("confirm 단계: 시간 초과"), StatusPending, true, true},
+		{"재시도 불가", "오늘의 운세", 1, Permanent(errors.New("발행 결과를 확인할 수 없음")), StatusFailed, false, false},
+		{"최대 시도 초과", "", 3, errors.New("수집 실패"), StatusFailed, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, _ := openTest(t)
+			job := enqueueClaim(t, q)
+			for i := 1; i < tt.attempts; i++ {
+				if _, _, err := q.Fail(job.ID, errors.New("이전 실패"), testNow); err != nil {
+					t.Fatal(err)
+				}
+				if job, _ = q.Claim(testNow.Add(time.Hour)); job == nil {
+					t.Fatal("재시도 작업을 꺼내지 못함")
+				}
+			}
+			if tt.title != "" {
+				if err := q.Publishing(job.ID, tt.title, 41, testNow); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			retryAt, retry, err := q.Fail(job.ID, tt.cause, testNow)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := get(t, q, job.ID)
+			if got.Status != tt.wantStatus || retry != tt.wantRetry || got.Verify != tt.wantVerify {
+				t.Errorf("status=%s retry=%v verify=%v, want %s %v %v", got.Status, retry, got.Verify, tt.wantStatus, tt.wantRetry, tt.wantVerify)
+			}
+			if retry && !retryAt.Equal(testNow.Add(testPolicy.Backoff(got.Attempts))) {
+				t.Errorf("retryAt = %s", retryAt)
+			}
+			if got.LastError != tt.cause.Error() {
+				t.Errorf("LastError = %q", got.LastError)
+			}
+		})
+	}
+}
+
+// TestPublishingAfterVerify Verify·baseline이 저장되고, 다시 발행을 시작하면 새 baseline으로 바뀜
+func TestPublishingAfterVerify(t *testing.T) {
+	q, dir := openTest(t)
+	job := enqueueClaim(t, q)
+	if err := q.Publishing(job.ID, "오늘의 운세", 41, testNow); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := q.Fail(job.ID, errors.New("publish 단계: 시간 초과"), testNow); err != nil {
+		t.Fatal(err)
+	}
+
+	// 파일에서 다시 읽어도 확인 대상과 baseline이 남아 있어야 함
+	reopened, err := Open(dir, testPolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := get(t, reopened, job.ID)
+	if !got.Verify || got.Title != "오늘의 운세" || got.Baseline != 41 {
+		t.Fatalf("reopened = verify %v title %q baseline %d", got.Verify, got.Title, got.Baseline)
+	}
+
+	// 확인 후 다시 발행을 시작하면 새 baseline으로 교체
+	retry, _ := reopened.Claim(testNow.Add(time.Hour))
+	if retry == nil || !retry.Verify {
+		t.Fatalf("claimed = %+v, want Verify", retry)
+	}
+	if err := reopened.Publishing(job.ID, "오늘의 운세", 57, testNow.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	got = get(t, reopened, job.ID)
+	if got.Verify || got.Baseline != 57 {
+		t.Errorf("after Publishing = verify %v baseline %d, want false 57", got.Verify, got.Baseline)
+	}
+}
+
+// TestRelease 취소된 작업은 시도 횟수를 되돌리고 발행 시작 여부만 남김
+func TestRelease(t *testing.T) {
+	tests := []struct {
+		name       string
+		title      string
+		wantVerify bool
+	}{
+		{"발행 전 취소", "", false},
+		{"발행 클릭 후 취소", "오늘의 운세", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, _ := openTest(t)
+			job := enqueueClaim(t, q)
+			if tt.title != "" {
+				if err := q.Publishing(job.ID, tt.title, 0, testNow); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := q.Release(job.ID, testNow); err != nil {
+				t.Fatal(err)
+			}
+			got := get(t, q, job.ID)
+			if got.Status != StatusPending || got.Attempts != 0 || got.Verify != tt.wantVerify {
+				t.Errorf("status=%s attempts=%d verify=%v, want pending 0 %v", got.Status, got.Attempts, got.Verify, tt.wantVerify)
+			}
+		})
+	}
+}
+
+// TestRecover 재시작 시 실행 중이던 작업 복구/만료
+func TestRecover(t *testing.T) {
+	tests := []struct {
+		name        string
+		title       string
+		late        time.Duration // 실행 예정 시각부터 재시작까지
+		window      time.Duration
+		wantStatus  Status
+		wantVerify  bool
+		wantExpired bool
+	}{
+		{"기간 안, 발행 전", "", 10 * time.Minute, time.Hour, StatusPending, false, false},
+		{"기간 안, 발행 시작", "오늘의 운세", 10 * time.Minute, time.Hour, StatusPending, true, false},
+		{"기간 지남", "오늘의 운세", 2 * time.Hour, time.Hour, StatusFailed, false, true},
+		{"놓친 작업 실행 안 함", "", time.Minute, 0, StatusFailed, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, _ := openTest(t)
+			job := enqueueClaim(t, q)
+			if tt.title != "" {
+				if err := q.Publishing(job.ID, tt.title, 3, testNow); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			now := testNow.Add(tt.late)
+			requeued, expired, err := q.Recover(now, func(Job) time.Duration { return tt.window })
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (len(expired) == 1) != tt.wantExpired || len(requeued)+len(expired) != 1 {
+				t.Fatalf("requeued=%d expired=%d", len(requeued), len(expired))
+			}
+			got := get(t, q, job.ID)
+			if got.Status != tt.wantStatus || got.Verify != tt.wantVerify {
+				t.Errorf("status=%s verify=%v, want %s %v", got.Status, got.Verify, tt.wantStatus, tt.wantVerify)
+			}
+			if got.Status == StatusPending && !got.DueAt.Equal(now) {
+				t.Errorf("DueAt = %s, want %s", got.DueAt, now)
+			}
+		})
+	}
+}
+
+// TestClaimSaveFailure 저장 실패 시 꺼낸 작업을 이전 상태로 되돌림
+func TestClaimSaveFailure(t *testing.T) {
+	q, dir := openTest(t)
+	if _, _, err := q.Enqueue(Job{Account: "main", Category: "fortune", ScheduledAt: testNow}, testNow); err != nil {
+		t.Fatal(err)
+	}
+
+	// 임시 파일 자리에 디렉토리가 있으면 저장 실패
+	tmp := filepath.Join(dir, "jobs.json.tmp")
+	if err := os.Mkdir(tmp, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if job, err := q.Claim(testNow); err == nil || job != nil {
+		t.Fatalf("Claim = %v, %v, want save error", job, err)
+	}
+	jobs := q.List()
+	if jobs[0].Status != StatusPending || jobs[0].Attempts != 0 {
+		t.Fatalf("after failed save: status=%s attempts=%d, want pending 0", jobs[0].Status, jobs[0].Attempts)
+	}
+
+	// 저장할 수 있게 되면 같은 작업을 다시 꺼냄
+	if err := os.Remove(tmp); err != nil {
+		t.Fatal(err)
+	}
+	job, err := q.Claim(testNow)
+	if err != nil || job == nil || job.Attempts != 1 {
+		t.Fatalf("Claim after recovery = %+v, %v", job, err)
+	}
+}
+
+// TestClaimOrder 실행 시각이 된 작업만 이른 순서로 꺼냄
+func TestClaimOrder(t *testing.T) {
+	q, _ := openTest(t)
+	for i, category := range []string{"late", "early", "future"} {
+		due := []time.Time{testNow.Add(-time.Minute), testNow.Add(-time.Hour), testNow.Add(time.Hour)}[i]
+		if _, _, err := q.Enqueue(Job{Account: "main", Category: category, ScheduledAt: due, DueAt: due}, testNow); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 같은 트리거는 다시 등록되지 않음
+	if _, added, _ := q.Enqueue(Job{Account: "main", Category: "late", ScheduledAt: testNow.Add(-time.Minute)}, testNow); added {
+		t.Error("duplicate job was added")
+	}
+
+	var got []string
+	for {
+		job, err := q.ClaimFunc(testNow, func(j Job) bool { return j.Account == "main" })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job == nil {
+			break
+		}
+		got = append(got, job.Category)
+	}
+	if len(got) != 2 || got[0] != "early" || got[1] != "late" {
+		t.Errorf("claim order = %v, want [early late]", got)
+	}
+}
@@ -0,0 +1,192 @@
+package runs
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Status 실행 결과
+type Status string
+
+const (
+	StatusPublished Status = "published" // 발행 (비공개/보호/예약 포함)
+	StatusDraft     Status = "draft"     // 임시저장
+	StatusSkipped   Status = "skipped"   // 수집 결과 없음 또는 중복 포스트
+	StatusPreview   Status = "preview"   // --dry-run (발행하지 않음)
+	StatusFailed    Status = "failed"    // 실패 (재시도할 시도 포함)
+	StatusCanceled  Status = "canceled"  // 종료로 중단
+)
+
+// 실행 경로
+const (
+	TriggerPost     = "post"     // tistory-bot post
+	TriggerRun      = "run"      // tistory-bot run
+	TriggerSchedule = "schedule" // 스케줄러 큐 작업
+)
+
+// Record 실행 기록 1건 (post/run의 카테고리 1개 또는 큐 작업 시도 1회)
+type Record struct {
+	Trigger   string    `json:"trigger"`
+	Job       string    `json:"job,omitempty"`     // 큐 작업 ID (스케줄러)
+	Attempt   int       `json:"attempt,omitempty"` // 큐 작업 시도 번호 (1부터)
+	Account   string    `json:"account"`
+	Category  string    `json:"category"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+	Status    Status    `json:"status"`
+	Title     string    `json:"title,omitempty"`
+	Fetched   []string  `json:"fetched,omitempty"`   // 수집기가 호출한 API ("호스트 상태")
+	Simulated []string  `json:"simulated,omitempty"` // API 대신 사용한 시뮬레이션 데이터 ("카테고리/데이터")
+	Thumbnail string    `json:"thumbnail,omitempty"` // 썸네일 파일 경로
+	Cover     string    `json:"cover,omitempty"`     // 대표이미지 출처 (thumbnail = 썸네일, body = 본문 첫 이미지, unconfirmed = 썸네일 설정 확인 못 함)
+	PostID    string    `json:"post_id,omitempty"`
+	URL       string    `json:"url,omitempty"`
+	Errors    []string  `json:"errors,omitempty"` // 오류 체인 (바깥 → 원인)
+}
+
+// Duration 실행 시간
+func (r *Record) Duration() time.Duration {
+	return r.EndedAt.Sub(r.StartedAt)
+}
+
+// Success 발행 또는 임시저장 성공
+func (r *Record) Success() bool {
+	return r.Status == StatusPublished || r.Status == StatusDraft
+}
+
+// Store 파일 기반 실행 기록 저장소 ({dir}/{account}.jsonl)
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewStore 저장소 생성
+func NewStore(dir string) *Store {
+	os.MkdirAll(dir, 0755)
+	return &Store{dir: dir}
+}
+
+// path 계정별 기록 파일 경로
+func (s *Store) path(account string) string {
+	return filepath.Join(s.dir, sanitize(account)+".jsonl")
+}
+
+// Add 기록 추가 (append-only)
+func (s *Store) Add(rec Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return fmt.Errorf("실행 기록 디렉토리 생성 실패: %w", err)
+	}
+
+	f, err := os.OpenFile(s.path(rec.Account), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("실행 기록 파일 열기 실패: %w", err)
+	}
+	defer f.Close()
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// List since 이후 시작된 계정의 기록 조회 (오래된 순)
+func (s *Store) List(account string, since time.Time) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(s.path(account))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var rec Record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			continue // 깨진 줄은 무시
+		}
+		if !rec.StartedAt.Before(since) {
+			records = append(records, rec)
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool { return records[i].StartedAt.Before(records[j].StartedAt) })
+	return records, scanner.Err()
+}
+
+// Stats 카테고리별 실행 집계
+type Stats struct {
+	Category string
+	Success  int // 발행 + 임시저장
+	Failed   int
+	Skipped  int // 건너뜀 + 미리보기 + 중단
+}
+
+// Rate 성공률 (성공 / (성공 + 실패), 시도가 없으면 ok=false)
+func (s *Stats) Rate() (float64, bool) {
+	total := s.Success + s.Failed
+	if total == 0 {
+		return 0, false
+	}
+	return float64(s.Success) / float64(total), true
+}
+
+// CategoryStats since 이후 시작된 기록의 카테고리별 집계 (카테고리 이름순)
+func CategoryStats(records []Record, since time.Time) []Stats {
+	byCategory := make(map[string]*Stats)
+	var order []string
+	for i := range records {
+		rec := &records[i]
+		if rec.StartedAt.Before(since) {
+			continue
+		}
+		st, ok := byCategory[rec.Category]
+		if !ok {
+			st = &Stats{Category: rec.Category}
+			byCategory[rec.Category] = st
+			order = append(order, rec.Category)
+		}
+		switch {
+		case rec.Success():
+			st.Success++
+		case rec.Status == StatusFailed:
+			st.Failed++
+		default:
+			st.Skipped++
+		}
+	}
+	sort.Strings(order)
+	stats := make([]Stats, 0, len(order))
+	for _, category := range order {
+		stats = append(stats, *byCategory[category])
+	}
+	return stats
+}
+
+// sanitize 파일명에 쓸 수 없는 문자 치환
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|':
+			return '_'
+		}
+		return r
+	}, name)
+}
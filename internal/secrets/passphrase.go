@@ -0,0 +1,77 @@
+package secrets
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// PassphraseEnv 비밀 저장소 암호 환경 변수 (스케줄러처럼 입력할 수 없는 실행용)
+const PassphraseEnv = "TISTORY_BOT_VAULT_PASSPHRASE"
+
+var (
+	passMu     sync.Mutex
+	passCached string
+	terminalIn = os.Stdin // 시작 시점의 표준 입력 (터미널 확인/에코 끄기용)
+	stdin      = bufio.NewReader(terminalIn)
+)
+
+// Passphrase 비밀 저장소 암호 (환경 변수 → 터미널 입력, 한 번 받으면 프로세스 동안 재사용)
+func Passphrase() (string, error) {
+	passMu.Lock()
+	defer passMu.Unlock()
+	if passCached != "" {
+		return passCached, nil
+	}
+	if pass := os.Getenv(PassphraseEnv); pass != "" {
+		passCached = pass
+		return pass, nil
+	}
+	if !IsTerminal() {
+		return "", fmt.Errorf("비밀 저장소 암호 필요: %s 환경 변수를 설정하세요", PassphraseEnv)
+	}
+	pass, err := Prompt("🔐 비밀 저장소 암호: ", true)
+	if err != nil {
+		return "", err
+	}
+	if pass == "" {
+		return "", errors.New("비밀 저장소 암호가 비어 있음")
+	}
+	passCached = pass
+	return pass, nil
+}
+
+// ForgetPassphrase 기억한 암호 지우기 (틀린 암호일 때 다시 묻도록)
+func ForgetPassphrase() {
+	passMu.Lock()
+	passCached = ""
+	passMu.Unlock()
+}
+
+// IsTerminal 표준 입력이 터미널인지 (/dev/null 같은 문자 장치는 터미널이 아님)
+func IsTerminal() bool {
+	return term.IsTerminal(int(terminalIn.Fd()))
+}
+
+// Prompt 표준 입력에서 한 줄 읽기 (hidden이면 터미널 입력을 화면에 표시하지 않음)
+func Prompt(label string, hidden bool) (string, error) {
+	fmt.Fprint(os.Stderr, label)
+	if hidden && IsTerminal() {
+		line, err := term.ReadPassword(int(terminalIn.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("입력 읽기 실패: %w", err)
+		}
+		return string(line), nil
+	}
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		return "", fmt.Errorf("입력 읽기 실패: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
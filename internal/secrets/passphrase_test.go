@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"bufio"
+	"os"
+	"testing"
+)
+
+// TestPromptNotTerminal /dev/null·파이프 입력은 터미널이 아니고, hidden이어도 한 줄을 그대로 읽음
+func TestPromptNotTerminal(t *testing.T) {
+	savedIn, savedReader := terminalIn, stdin
+	t.Cleanup(func() { terminalIn, stdin = savedIn, savedReader })
+
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devNull.Close()
+	terminalIn = devNull
+	if IsTerminal() {
+		t.Errorf("IsTerminal() with %s = true", os.DevNull)
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	w.WriteString("pipe-passphrase\r\n")
+	w.Close()
+	terminalIn, stdin = r, bufio.NewReader(r)
+	if IsTerminal() {
+		t.Error("IsTerminal() with pipe = true")
+	}
+	got, err := Prompt("", true)
+	if err != nil || got != "pipe-passphrase" {
+		t.Errorf("Prompt = %q, %v, want pipe-passphrase", got, err)
+	}
+	if _, err := Prompt("", true); err == nil {
+		t.Error("Prompt at EOF succeeded")
+	}
+}
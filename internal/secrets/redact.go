@@ -0,0 +1,179 @@
+package secrets
+
+import (
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// Mask 가린 비밀 값 대신 출력하는 문자열
+const Mask = "****"
+
+// minRedactLen 이보다 짧은 값은 가리지 않음 (흔한 문자열까지 가려 출력이 망가지는 것 방지)
+const minRedactLen = 4
+
+var (
+	redactMu sync.RWMutex
+	redacted = map[string]bool{}
+	replacer *strings.Replacer
+)
+
+// Register 출력에서 가릴 비밀 값 등록
+func Register(values ...string) {
+	redactMu.Lock()
+	defer redactMu.Unlock()
+	changed := false
+	for _, v := range values {
+		if len(v) < minRedactLen || redacted[v] {
+			continue
+		}
+		redacted[v] = true
+		changed = true
+	}
+	if !changed {
+		return
+	}
+
+	// 긴 값부터 바꿔야 다른 비밀 값을 포함한 값이 통째로 가려짐
+	list := make([]string, 0, len(redacted))
+	for v := range redacted {
+		list = append(list, v)
+	}
+	sort.Slice(list, func(i, j int) bool { return len(list[i]) > len(list[j]) })
+	pairs := make([]string, 0, len(list)*2)
+	for _, v := range list {
+		pairs = append(pairs, v, Mask)
+	}
+	replacer = strings.NewReplacer(pairs...)
+}
+
+// partialSecret s의 끝부분 중 등록된 비밀 값의 앞부분일 수 있는 가장 긴 길이
+// (스트림을 조각으로 가릴 때 다음 조각과 이어 붙여야 하는 부분)
+func partialSecret(s string) int {
+	redactMu.RLock()
+	defer redactMu.RUnlock()
+	held := 0
+	for v := range redacted {
+		for k := min(len(v)-1, len(s)); k > held; k-- {
+			if strings.HasSuffix(s, v[:k]) {
+				held = k
+				break
+			}
+		}
+	}
+	return held
+}
+
+// Redact 등록된 비밀 값을 가린 문자열
+func Redact(s string) string {
+	redactMu.RLock()
+	r := replacer
+	redactMu.RUnlock()
+	if r == nil {
+		return s
+	}
+	return r.Replace(s)
+}
+
+// redactWriter 쓰기 전에 비밀 값을 가리는 Writer
+type redactWriter struct {
+	w io.Writer
+}
+
+// NewRedactWriter w에 쓰기 전에 등록된 비밀 값을 가리는 Writer (로그 파일용)
+func NewRedactWriter(w io.Writer) io.Writer {
+	return redactWriter{w: w}
+}
+
+func (r redactWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(r.w, Redact(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// MaskValue 화면 표시용으로 값 가리기 (빈 값은 빈 문자열)
+func MaskValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return Mask
+}
+
+// terminalOut 가림 필터로 바꾸기 전의 표준 출력 (터미널 확인용)
+var terminalOut = os.Stdout
+
+// OutputIsTerminal 표준 출력이 터미널인지 (FilterStdio로 파이프로 바꾼 뒤에도 원래 출력 기준)
+func OutputIsTerminal() bool {
+	return term.IsTerminal(int(terminalOut.Fd()))
+}
+
+// FilterStdio 표준 출력/에러를 가림 필터로 교체 (이후 fmt.Print* 출력도 모두 가려짐)
+// 반환 함수는 남은 출력을 내보내고 원래 출력으로 되돌린다. 종료 전에 반드시 호출해야 한다.
+// 교체 후에는 os.Stdout이 파이프이므로 터미널 여부는 OutputIsTerminal로 확인한다.
+func FilterStdio() (restore func()) {
+	stdout, stderr := os.Stdout, os.Stderr
+	terminalOut = stdout
+	outDone, errDone := make(chan struct{}), make(chan struct{})
+	outW, ok1 := pipeTo(stdout, outDone)
+	errW, ok2 := pipeTo(stderr, errDone)
+	if !ok1 || !ok2 {
+		for _, w := range []*os.File{outW, errW} {
+			if w != nil {
+				w.Close()
+			}
+		}
+		<-outDone
+		<-errDone
+		return func() {}
+	}
+	os.Stdout, os.Stderr = outW, errW
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			os.Stdout, os.Stderr = stdout, stderr
+			outW.Close()
+			errW.Close()
+			<-outDone
+			<-errDone
+		})
+	}
+}
+
+// pipeTo dst로 가린 출력을 복사하는 파이프 (done은 복사가 끝나면 닫힘)
+// 비밀 값이 두 번의 읽기에 걸쳐 나뉘어도 가려지도록, 비밀 값의 앞부분일 수 있는 끝부분은
+// 다음 읽기와 이어 붙일 때까지 내보내지 않는다 (줄 단위로 모으지 않아 입력 안내도 바로 보임).
+func pipeTo(dst *os.File, done chan struct{}) (*os.File, bool) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		close(done)
+		return nil, false
+	}
+	go func() {
+		defer close(done)
+		defer r.Close()
+		buf := make([]byte, 32*1024)
+		pending := ""
+		for {
+			n, err := r.Read(buf)
+			if n > 0 {
+				out := Redact(pending + string(buf[:n]))
+				held := partialSecret(out)
+				pending = out[len(out)-held:]
+				io.WriteString(dst, out[:len(out)-held])
+			}
+			if err != nil {
+				if pending != "" {
+					io.WriteString(dst, pending)
+				}
+				return
+			}
+		}
+	}()
+	return w, true
+}
@@ -0,0 +1,120 @@
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// 참조 종류
+const (
+	KindEnv   = "env"   // ${ENV_VAR}
+	KindFile  = "file"  // file:/path/to/secret
+	KindVault = "vault" // vault:name
+)
+
+// envRef 환경 변수 참조 (${NAME})
+var envRef = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Kind 값이 어떤 참조인지 (참조가 아니면 빈 문자열)
+func Kind(value string) string {
+	switch {
+	case strings.HasPrefix(value, "file:"):
+		return KindFile
+	case strings.HasPrefix(value, "vault:"):
+		return KindVault
+	case envRef.MatchString(value):
+		return KindEnv
+	}
+	return ""
+}
+
+// Resolver 설정 값의 비밀 참조를 실제 값으로 바꿈
+// 저장소는 vault: 참조를 처음 만났을 때 한 번만 연다.
+type Resolver struct {
+	VaultPath string // 비밀 저장소 파일 경로
+
+	vault *Vault
+}
+
+// NewResolver 저장소 경로로 Resolver 생성
+func NewResolver(vaultPath string) *Resolver {
+	return &Resolver{VaultPath: vaultPath}
+}
+
+// Resolve 참조를 실제 값으로 바꿈 (참조가 아니면 그대로, ok=false)
+// 바꾼 값은 로그 가림 목록에 등록된다.
+func (r *Resolver) Resolve(value string) (resolved string, ok bool, err error) {
+	switch Kind(value) {
+	case KindFile:
+		resolved, err = r.file(strings.TrimPrefix(value, "file:"))
+	case KindVault:
+		resolved, err = r.lookup(strings.TrimPrefix(value, "vault:"))
+	case KindEnv:
+		resolved, err = expandEnv(value)
+	default:
+		return value, false, nil
+	}
+	if err != nil {
+		return "", true, err
+	}
+	Register(resolved)
+	return resolved, true, nil
+}
+
+// file 파일 내용 (끝 줄바꿈 제거)
+func (r *Resolver) file(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("file: 경로가 비어 있음")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("비밀 파일 읽기 실패: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// lookup 저장소에서 이름으로 조회
+func (r *Resolver) lookup(name string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("vault: 이름이 비어 있음")
+	}
+	if r.vault == nil {
+		if !Exists(r.VaultPath) {
+			return "", fmt.Errorf("비밀 저장소 없음: %s (tistory-bot secrets init 으로 생성)", r.VaultPath)
+		}
+		pass, err := Passphrase()
+		if err != nil {
+			return "", err
+		}
+		v, err := Open(r.VaultPath, pass)
+		if err != nil {
+			ForgetPassphrase()
+			return "", err
+		}
+		r.vault = v
+	}
+	value, ok := r.vault.Get(name)
+	if !ok {
+		return "", fmt.Errorf("비밀 저장소에 %q 없음 (tistory-bot secrets set %s)", name, name)
+	}
+	return value, nil
+}
+
+// expandEnv 값 안의 ${NAME}을 모두 환경 변수로 바꿈 (없는 변수는 에러)
+func expandEnv(value string) (string, error) {
+	var missing []string
+	out := envRef.ReplaceAllStringFunc(value, func(ref string) string {
+		name := envRef.FindStringSubmatch(ref)[1]
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return v
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("환경 변수가 설정되지 않음: %s", strings.Join(missing, ", "))
+	}
+	return out, nil
+}
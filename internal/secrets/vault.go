@@ -0,0 +1,210 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// ErrBadPassphrase 암호가 틀렸거나 저장소 파일이 손상됨
+var ErrBadPassphrase = errors.New("비밀 저장소 암호가 틀렸거나 파일이 손상됨")
+
+const (
+	vaultVersion    = 1
+	vaultKDF        = "pbkdf2-sha256"
+	vaultIterations = 600000 // PBKDF2-SHA256 권장 반복 횟수
+	saltSize        = 16
+	keySize         = 32 // AES-256
+)
+
+// vaultFile 저장소 파일 형식 (값 목록은 AES-256-GCM으로 암호화)
+type vaultFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Vault 암호로 잠근 로컬 비밀 저장소 (이름 → 값)
+type Vault struct {
+	path       string
+	salt       []byte
+	iterations int
+	key        []byte
+	values     map[string]string
+}
+
+// Exists 저장소 파일이 있는지 확인
+func Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// Create 빈 저장소 생성 (파일이 이미 있으면 에러)
+func Create(path, passphrase string) (*Vault, error) {
+	if Exists(path) {
+		return nil, fmt.Errorf("비밀 저장소가 이미 있음: %s", path)
+	}
+	if passphrase == "" {
+		return nil, errors.New("비밀 저장소 암호가 비어 있음")
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("salt 생성 실패: %w", err)
+	}
+	key, err := deriveKey(passphrase, salt, vaultIterations)
+	if err != nil {
+		return nil, err
+	}
+	v := &Vault{path: path, salt: salt, iterations: vaultIterations, key: key, values: map[string]string{}}
+	return v, v.Save()
+}
+
+// Open 저장소 파일을 암호로 열기
+func Open(path, passphrase string) (*Vault, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("비밀 저장소 읽기 실패: %w", err)
+	}
+	var f vaultFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("비밀 저장소 파싱 실패 (%s): %w", path, err)
+	}
+	if f.Version != vaultVersion || f.KDF != vaultKDF {
+		return nil, fmt.Errorf("지원하지 않는 비밀 저장소 형식: version %d, kdf %s", f.Version, f.KDF)
+	}
+
+	key, err := deriveKey(passphrase, f.Salt, f.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := gcm.Open(nil, f.Nonce, f.Ciphertext, additionalData(f.Salt))
+	if err != nil {
+		return nil, ErrBadPassphrase
+	}
+
+	v := &Vault{path: path, salt: f.Salt, iterations: f.Iterations, key: key, values: map[string]string{}}
+	if err := json.Unmarshal(plain, &v.values); err != nil {
+		return nil, fmt.Errorf("비밀 저장소 내용 파싱 실패: %w", err)
+	}
+	return v, nil
+}
+
+// Get 이름으로 값 조회
+func (v *Vault) Get(name string) (string, bool) {
+	value, ok := v.values[name]
+	return value, ok
+}
+
+// Set 값 저장 (Save 호출 전까지 파일에 반영되지 않음)
+func (v *Vault) Set(name, value string) {
+	v.values[name] = value
+}
+
+// Delete 값 삭제 (없으면 false)
+func (v *Vault) Delete(name string) bool {
+	if _, ok := v.values[name]; !ok {
+		return false
+	}
+	delete(v.values, name)
+	return true
+}
+
+// Names 저장된 이름 목록 (정렬)
+func (v *Vault) Names() []string {
+	names := make([]string, 0, len(v.values))
+	for name := range v.values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Rekey 새 암호로 바꿈 (salt도 새로 생성, Save 호출 필요)
+func (v *Vault) Rekey(passphrase string) error {
+	if passphrase == "" {
+		return errors.New("비밀 저장소 암호가 비어 있음")
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("salt 생성 실패: %w", err)
+	}
+	key, err := deriveKey(passphrase, salt, vaultIterations)
+	if err != nil {
+		return err
+	}
+	v.salt, v.iterations, v.key = salt, vaultIterations, key
+	return nil
+}
+
+// Save 암호화해서 파일에 저장 (임시 파일에 쓴 뒤 교체, 권한 0600)
+func (v *Vault) Save() error {
+	plain, err := json.Marshal(v.values)
+	if err != nil {
+		return fmt.Errorf("비밀 저장소 직렬화 실패: %w", err)
+	}
+	gcm, err := newGCM(v.key)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("nonce 생성 실패: %w", err)
+	}
+	data, err := json.MarshalIndent(vaultFile{
+		Version:    vaultVersion,
+		KDF:        vaultKDF,
+		Iterations: v.iterations,
+		Salt:       v.salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plain, additionalData(v.salt)),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("비밀 저장소 직렬화 실패: %w", err)
+	}
+
+	tmp := v.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("비밀 저장소 저장 실패: %w", err)
+	}
+	if err := os.Rename(tmp, v.path); err != nil {
+		return fmt.Errorf("비밀 저장소 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// deriveKey 암호에서 AES 키 유도
+func deriveKey(passphrase string, salt []byte, iterations int) ([]byte, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, keySize)
+	if err != nil {
+		return nil, fmt.Errorf("키 유도 실패: %w", err)
+	}
+	return key, nil
+}
+
+// newGCM AES-256-GCM 생성
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("암호화 초기화 실패: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// additionalData 암호문과 함께 인증하는 헤더 값 (형식/salt 바꿔치기 방지)
+func additionalData(salt []byte) []byte {
+	return append([]byte(fmt.Sprintf("tistory-bot-vault/v%d/%s/", vaultVersion, vaultKDF)), salt...)
+}
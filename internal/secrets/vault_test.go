@@ -0,0 +1,146 @@
+package secrets
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testPassphrase = "correct horse battery"
+	testSecret     = "kakao-pw-Zx81!q"
+)
+
+// createVault 값 2개를 저장한 저장소 파일
+func createVault(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "secrets.vault")
+	v, err := Create(path, testPassphrase)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	v.Set("KAKAO_PASSWORD", testSecret)
+	v.Set("TMDB_KEY", "tmdb-0123456789")
+	if err := v.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	return path
+}
+
+// TestVaultRoundTrip 저장한 값을 같은 암호로 다시 읽음 (파일에는 평문 없음)
+func TestVaultRoundTrip(t *testing.T) {
+	path := createVault(t)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), testSecret) || strings.Contains(string(data), "KAKAO_PASSWORD") {
+		t.Errorf("저장소 파일에 평문이 있음:\n%s", data)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("mode = %v, %v, want 0600", info.Mode().Perm(), err)
+	}
+
+	v, err := Open(path, testPassphrase)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if got, ok := v.Get("KAKAO_PASSWORD"); !ok || got != testSecret {
+		t.Errorf("Get(KAKAO_PASSWORD) = %q, %v", got, ok)
+	}
+	if got := strings.Join(v.Names(), ","); got != "KAKAO_PASSWORD,TMDB_KEY" {
+		t.Errorf("Names() = %q", got)
+	}
+
+	// 삭제 후 저장해도 나머지 값은 유지
+	if !v.Delete("TMDB_KEY") || v.Delete("TMDB_KEY") {
+		t.Error("Delete: 처음만 true여야 함")
+	}
+	if err := v.Save(); err != nil {
+		t.Fatal(err)
+	}
+	v, err = Open(path, testPassphrase)
+	if err != nil {
+		t.Fatalf("Open after delete: %v", err)
+	}
+	if got := strings.Join(v.Names(), ","); got != "KAKAO_PASSWORD" {
+		t.Errorf("Names() after delete = %q", got)
+	}
+
+	if _, err := Create(path, testPassphrase); err == nil {
+		t.Error("Create over existing vault succeeded")
+	}
+}
+
+// TestVaultRejects 틀린 암호·변조된 파일은 ErrBadPassphrase
+func TestVaultRejects(t *testing.T) {
+	tests := []struct {
+		name       string
+		passphrase string
+		tamper     func(f *vaultFile)
+	}{
+		{"틀린 암호", "wrong horse battery", nil},
+		{"빈 암호", "", nil},
+		{"암호문 변조", testPassphrase, func(f *vaultFile) { f.Ciphertext[0] ^= 0xff }},
+		{"nonce 변조", testPassphrase, func(f *vaultFile) { f.Nonce[0] ^= 0xff }},
+		{"salt 바꿔치기", testPassphrase, func(f *vaultFile) { f.Salt[0] ^= 0xff }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := createVault(t)
+			if tt.tamper != nil {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var f vaultFile
+				if err := json.Unmarshal(data, &f); err != nil {
+					t.Fatal(err)
+				}
+				tt.tamper(&f)
+				if data, err = json.Marshal(f); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, data, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := Open(path, tt.passphrase); !errors.Is(err, ErrBadPassphrase) {
+				t.Errorf("Open = %v, want ErrBadPassphrase", err)
+			}
+		})
+	}
+}
+
+// TestVaultRekey 새 암호로 바꾸면 이전 암호로는 열리지 않음
+func TestVaultRekey(t *testing.T) {
+	path := createVault(t)
+	v, err := Open(path, testPassphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Rekey(""); err == nil {
+		t.Error("Rekey(\"\") succeeded")
+	}
+	if err := v.Rekey("new passphrase"); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Open(path, testPassphrase); !errors.Is(err, ErrBadPassphrase) {
+		t.Errorf("Open with old passphrase = %v, want ErrBadPassphrase", err)
+	}
+	v, err = Open(path, "new passphrase")
+	if err != nil {
+		t.Fatalf("Open with new passphrase: %v", err)
+	}
+	if got, _ := v.Get("KAKAO_PASSWORD"); got != testSecret {
+		t.Errorf("Get(KAKAO_PASSWORD) = %q", got)
+	}
+}
@@ -0,0 +1,47 @@
+package theme
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+	"unicode/utf8"
+)
+
+// funcs 템플릿 공용 함수
+var funcs = template.FuncMap{
+	"inc":      func(i int) int { return i + 1 },
+	"add":      func(a, b int) int { return a + b },
+	"join":     strings.Join,
+	"truncate": truncate,
+	"comma":    comma,
+	"signed":   func(f float64) string { return fmt.Sprintf("%+.2f", f) },
+	"safeHTML": func(s string) template.HTML { return template.HTML(s) },
+}
+
+// truncate 글자 수(rune) 기준 자르기
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max]) + "..."
+}
+
+// comma 천 단위 구분 (1234567 → 1,234,567)
+func comma(n int64) string {
+	s := fmt.Sprintf("%d", n)
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	if neg {
+		return "-" + b.String()
+	}
+	return b.String()
+}
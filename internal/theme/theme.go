@@ -0,0 +1,128 @@
+package theme
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultName 내장 기본 테마 이름
+const DefaultName = "default"
+
+// ErrTemplateNotFound 테마에 해당 템플릿이 없음
+var ErrTemplateNotFound = errors.New("템플릿을 찾을 수 없음")
+
+//go:embed themes/default/*.html
+var defaultFS embed.FS
+
+// Theme 포스트 본문 템플릿 묶음 (내장 기본 테마 위에 테마 디렉토리의 파일을 덮어씀)
+type Theme struct {
+	Name   string
+	Source string // 테마 디렉토리 (내장이면 "embedded")
+	tmpl   *template.Template
+}
+
+// LayoutData layout 템플릿에 전달되는 데이터
+type LayoutData struct {
+	Template string        // 본문 템플릿 이름 (예: weather)
+	Body     template.HTML // 렌더링된 본문
+}
+
+// 로드한 테마 캐시 (디렉토리 + 이름 단위)
+var (
+	cacheMu sync.Mutex
+	cache   = map[string]*Theme{}
+)
+
+// Default 내장 기본 테마
+func Default() *Theme {
+	t, err := Load("", DefaultName)
+	if err != nil {
+		panic(fmt.Sprintf("theme: 내장 테마 파싱 실패: %v", err))
+	}
+	return t
+}
+
+// Load 테마 로드 (name이 비었거나 default이고 dir/default가 없으면 내장 테마)
+// {dir}/{name}/*.html 파일은 같은 이름의 내장 템플릿을 대체하고,
+// 파일에 없는 템플릿은 내장 기본 테마를 사용한다.
+func Load(dir, name string) (*Theme, error) {
+	if name == "" {
+		name = DefaultName
+	}
+	key := dir + "\x00" + name
+
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if t, ok := cache[key]; ok {
+		return t, nil
+	}
+
+	base, err := template.New(name).Funcs(funcs).ParseFS(defaultFS, "themes/default/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("내장 테마 파싱 실패: %w", err)
+	}
+	t := &Theme{Name: name, Source: "embedded", tmpl: base}
+
+	themeDir := filepath.Join(dir, name)
+	if dir != "" {
+		files, _ := filepath.Glob(filepath.Join(themeDir, "*.html"))
+		switch {
+		case len(files) > 0:
+			if _, err := base.ParseFiles(files...); err != nil {
+				return nil, fmt.Errorf("테마 '%s' 파싱 실패: %w", name, err)
+			}
+			t.Source = themeDir
+		case name != DefaultName:
+			return nil, fmt.Errorf("테마 '%s' 없음: %s/*.html", name, themeDir)
+		}
+	} else if name != DefaultName {
+		return nil, fmt.Errorf("테마 '%s' 없음: themes_dir 미설정", name)
+	}
+
+	cache[key] = t
+	return t, nil
+}
+
+// Render 본문 템플릿(name.html)을 렌더링한 뒤 layout.html로 감싼 HTML 반환
+func (t *Theme) Render(name string, data interface{}) (string, error) {
+	file := name + ".html"
+	if t.tmpl.Lookup(file) == nil {
+		return "", fmt.Errorf("%w: %s (테마 %s)", ErrTemplateNotFound, name, t.Name)
+	}
+
+	var body bytes.Buffer
+	if err := t.tmpl.ExecuteTemplate(&body, file, data); err != nil {
+		return "", fmt.Errorf("%s 렌더링 실패: %w", name, err)
+	}
+
+	var out bytes.Buffer
+	layout := LayoutData{Template: name, Body: template.HTML(body.String())}
+	if err := t.tmpl.ExecuteTemplate(&out, "layout.html", layout); err != nil {
+		return "", fmt.Errorf("layout 렌더링 실패: %w", err)
+	}
+	return out.String(), nil
+}
+
+// Templates 테마에 정의된 본문 템플릿 이름 목록 (layout, 공용 조각 제외)
+func (t *Theme) Templates() []string {
+	var names []string
+	for _, tmpl := range t.tmpl.Templates() {
+		name := tmpl.Name()
+		if !strings.HasSuffix(name, ".html") || name == "layout.html" || strings.HasPrefix(name, "_") {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(name, ".html"))
+	}
+	return names
+}
+
+// Describe 테마 출처 설명 (로그용)
+func (t *Theme) Describe() string {
+	return fmt.Sprintf("%s (%s)", t.Name, t.Source)
+}
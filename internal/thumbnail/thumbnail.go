@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
@@ -27,80 +28,25 @@ type CategoryStyle struct {
 	SubText       string
 }
 
-// 카테고리별 스타일 정의 (이모지 대신 텍스트 아이콘 사용 - 폰트 호환성)
-var categoryStyles = map[string]CategoryStyle{
-	"crypto": {
-		GradientStart: color.RGBA{255, 175, 0, 255},   // 골드
-		GradientEnd:   color.RGBA{255, 100, 0, 255},   // 오렌지
-		Emoji:         "BTC",
-		SubText:       "암호화폐 시세",
-	},
-	"tech": {
-		GradientStart: color.RGBA{0, 150, 255, 255},   // 블루
-		GradientEnd:   color.RGBA{100, 50, 200, 255},  // 퍼플
-		Emoji:         "TECH",
-		SubText:       "IT/테크 뉴스",
-	},
-	"movie": {
-		GradientStart: color.RGBA{220, 20, 60, 255},   // 크림슨
-		GradientEnd:   color.RGBA{139, 0, 139, 255},   // 다크마젠타
-		Emoji:         "MOVIE",
-		SubText:       "영화/드라마",
-	},
-	"trend": {
-		GradientStart: color.RGBA{255, 65, 108, 255},  // 핑크
-		GradientEnd:   color.RGBA{255, 75, 43, 255},   // 레드오렌지
-		Emoji:         "HOT",
-		SubText:       "실시간 트렌드",
-	},
-	"lotto": {
-		GradientStart: color.RGBA{50, 205, 50, 255},   // 라임그린
-		GradientEnd:   color.RGBA{34, 139, 34, 255},   // 포레스트그린
-		Emoji:         "LOTTO",
-		SubText:       "로또 당첨번호",
-	},
-	"lotto-predict": {
-		GradientStart: color.RGBA{138, 43, 226, 255},  // 블루바이올렛
-		GradientEnd:   color.RGBA{75, 0, 130, 255},    // 인디고
-		Emoji:         "AI",
-		SubText:       "로또 예측",
-	},
-	"fortune": {
-		GradientStart: color.RGBA{255, 215, 0, 255},   // 골드
-		GradientEnd:   color.RGBA{255, 140, 0, 255},   // 다크오렌지
-		Emoji:         "FORTUNE",
-		SubText:       "오늘의 운세",
-	},
-	"sports": {
-		GradientStart: color.RGBA{0, 184, 148, 255},   // 그린
-		GradientEnd:   color.RGBA{0, 206, 201, 255},   // 시안
-		Emoji:         "SPORTS",
-		SubText:       "스포츠 뉴스",
-	},
-	"golf": {
-		GradientStart: color.RGBA{46, 125, 50, 255},   // 그린
-		GradientEnd:   color.RGBA{76, 175, 80, 255},   // 라이트그린
-		Emoji:         "GOLF",
-		SubText:       "골프 날씨",
-	},
-	"golf-tips": {
-		GradientStart: color.RGBA{27, 94, 32, 255},    // 다크그린
-		GradientEnd:   color.RGBA{56, 142, 60, 255},   // 그린
-		Emoji:         "LESSON",
-		SubText:       "골프 레슨",
-	},
-	"coupang": {
-		GradientStart: color.RGBA{230, 57, 70, 255},   // 쿠팡 레드
-		GradientEnd:   color.RGBA{168, 50, 62, 255},   // 다크레드
-		Emoji:         "DEAL",
-		SubText:       "오늘의 특가",
-	},
-	"error": {
-		GradientStart: color.RGBA{45, 52, 54, 255},    // 다크그레이
-		GradientEnd:   color.RGBA{99, 110, 114, 255},  // 그레이
-		Emoji:         "DEBUG",
-		SubText:       "에러 해결",
-	},
+// 카테고리별 스타일 (collector 레지스트리가 등록)
+var (
+	stylesMu       sync.RWMutex
+	categoryStyles = make(map[string]CategoryStyle)
+)
+
+// RegisterStyle 카테고리 썸네일 스타일 등록
+func RegisterStyle(category string, style CategoryStyle) {
+	stylesMu.Lock()
+	defer stylesMu.Unlock()
+	categoryStyles[category] = style
+}
+
+// lookupStyle 카테고리 스타일 조회
+func lookupStyle(category string) (CategoryStyle, bool) {
+	stylesMu.RLock()
+	defer stylesMu.RUnlock()
+	style, ok := categoryStyles[category]
+	return style, ok
 }
 
 // NewGenerator 썸네일 생성기 생성
@@ -120,7 +66,7 @@ func (g *Generator) Generate(category, title string) (string, error) {
 	dc := gg.NewContext(g.Width, g.Height)
 
 	// 스타일 가져오기
-	style, ok := categoryStyles[category]
+	style, ok := lookupStyle(category)
 	if !ok {
 		style = CategoryStyle{
 			GradientStart: color.RGBA{100, 100, 100, 255},
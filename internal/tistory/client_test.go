@@ -0,0 +1,356 @@
+package tistory
+
+import (
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/tistory/fake"
+	"github.com/go-rod/rod/lib/launcher"
+)
+
+// 가짜 서버 계정
+const (
+	testEmail    = "bot@example.com"
+	testPassword = "secret-pw"
+)
+
+// newFakeClient 가짜 서버와 그 서버를 바라보는 헤드리스 클라이언트 (브라우저가 없으면 건너뜀)
+func newFakeClient(t *testing.T, opts fake.Options) (*Client, *fake.Server) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("브라우저 테스트는 -short에서 건너뜀")
+	}
+	if _, ok := launcher.LookPath(); !ok {
+		t.Skip("Chrome/Chromium 없음")
+	}
+
+	srv := fake.NewServer(opts)
+	t.Cleanup(srv.Close)
+
+	c := NewClient(testEmail, testPassword, "fake", true, 0)
+	c.userDataDir = t.TempDir() // 이전 테스트의 세션이 남지 않도록
+	c.SetBaseURL(srv.URL)
+	t.Cleanup(func() { c.Close() })
+	return c, srv
+}
+
+// testContext 테스트 1건 제한 시간
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// writeThumbnail 업로드용 PNG 파일
+func writeThumbnail(t *testing.T) string {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 40, 30))
+	for x := 0; x < 40; x++ {
+		for y := 0; y < 30; y++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 80, B: 40, A: 255})
+		}
+	}
+	path := filepath.Join(t.TempDir(), "thumb.png")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLogin(t *testing.T) {
+	c, srv := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword})
+	ctx := testContext(t)
+
+	if err := c.Login(ctx); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if !c.LoggedIn() {
+		t.Error("LoggedIn() = false, want true")
+	}
+	if got := srv.LoginAttempts(); got != 1 {
+		t.Errorf("login attempts = %d, want 1", got)
+	}
+
+	// 세션이 남아 있으면 폼을 다시 제출하지 않음
+	if err := c.Login(ctx); err != nil {
+		t.Fatalf("second Login: %v", err)
+	}
+	if got := srv.LoginAttempts(); got != 1 {
+		t.Errorf("login attempts after session reuse = %d, want 1", got)
+	}
+}
+
+func TestLoginFailure(t *testing.T) {
+	c, _ := newFakeClient(t, fake.Options{Email: testEmail, Password: "other-pw"})
+
+	err := c.Login(testContext(t))
+	if !errors.Is(err, ErrLoginFailed) {
+		t.Fatalf("Login error = %v, want ErrLoginFailed", err)
+	}
+	if c.LoggedIn() {
+		t.Error("LoggedIn() = true after failed login")
+	}
+}
+
+func TestLoginCaptcha(t *testing.T) {
+	c, _ := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword, Captcha: true})
+
+	err := c.Login(testContext(t))
+	if !errors.Is(err, ErrCaptcha) {
+		t.Fatalf("Login error = %v, want ErrCaptcha", err)
+	}
+}
+
+func TestGetCategories(t *testing.T) {
+	c, _ := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword, Categories: []string{"골프", "운세"}})
+
+	categories, err := c.GetCategories(testContext(t))
+	if err != nil {
+		t.Fatalf("GetCategories: %v", err)
+	}
+	var names []string
+	for _, cat := range categories {
+		names = append(names, cat.Name)
+	}
+	if got := strings.Join(names, ","); got != "골프,운세" {
+		t.Errorf("categories = %q, want %q", got, "골프,운세")
+	}
+}
+
+func TestWritePost(t *testing.T) {
+	c, srv := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword, Categories: []string{"골프", "운세"}})
+
+	result, err := c.WritePost(testContext(t), Post{
+		Title:    "오늘의 골프 팁",
+		Content:  "<p>백스윙은 천천히</p>",
+		Category: "골프",
+		Tags:     []string{"골프", "스윙", "골프"},
+	})
+	if err != nil {
+		t.Fatalf("WritePost: %v", err)
+	}
+
+	posts := srv.Posts()
+	if len(posts) != 1 {
+		t.Fatalf("posts = %d, want 1", len(posts))
+	}
+	got := posts[0]
+	if got.Title != "오늘의 골프 팁" {
+		t.Errorf("title = %q", got.Title)
+	}
+	if !strings.Contains(got.Content, "백스윙은 천천히") {
+		t.Errorf("content = %q", got.Content)
+	}
+	if tags := strings.Join(got.Tags, ","); tags != "골프,스윙" {
+		t.Errorf("tags = %q, want %q", tags, "골프,스윙")
+	}
+	if got.Category != "골프" {
+		t.Errorf("category = %q, want %q", got.Category, "골프")
+	}
+	if got.Visibility != "공개" {
+		t.Errorf("visibility = %q, want 공개", got.Visibility)
+	}
+	if result.PostID != strconv.Itoa(got.PostID) {
+		t.Errorf("result post ID = %q, want %d", result.PostID, got.PostID)
+	}
+	if result.Draft {
+		t.Error("result.Draft = true")
+	}
+}
+
+func TestWritePostThumbnail(t *testing.T) {
+	c, srv := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword})
+	thumb := writeThumbnail(t)
+
+	result, err := c.WritePost(testContext(t), Post{
+		Title:     "썸네일 글",
+		Content:   "<p>본문</p>",
+		Thumbnail: thumb,
+	})
+	if err != nil {
+		t.Fatalf("WritePost: %v", err)
+	}
+
+	var thumbs int
+	for _, u := range srv.Uploads() {
+		if u.Field == "thumbnail" {
+			thumbs++
+		}
+	}
+	if thumbs != 1 {
+		t.Errorf("thumbnail uploads = %d, want 1", thumbs)
+	}
+	posts := srv.Posts()
+	if len(posts) != 1 || posts[0].Thumbnail == "" {
+		t.Fatalf("posted thumbnail missing: %+v", posts)
+	}
+	if result.Cover.Source != CoverThumbnail || result.Cover.URL == "" {
+		t.Errorf("cover = %+v, want confirmed thumbnail", result.Cover)
+	}
+	if strings.Contains(posts[0].Content, "<img") {
+		t.Errorf("thumbnail should not be in body without CoverInBody: %q", posts[0].Content)
+	}
+}
+
+func TestWritePostDraftThumbnail(t *testing.T) {
+	c, srv := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword})
+
+	result, err := c.WritePost(testContext(t), Post{
+		Title:     "임시저장 글",
+		Content:   "<p>본문</p>",
+		Thumbnail: writeThumbnail(t),
+		Options:   PublishOptions{Draft: true},
+	})
+	if err != nil {
+		t.Fatalf("WritePost: %v", err)
+	}
+	if !result.Draft {
+		t.Error("result.Draft = false")
+	}
+	if len(srv.Posts()) != 0 {
+		t.Errorf("draft was published: %+v", srv.Posts())
+	}
+	drafts := srv.Drafts()
+	if len(drafts) == 0 || !strings.Contains(drafts[len(drafts)-1].Content, "<img") {
+		t.Fatalf("draft body has no thumbnail: %+v", drafts)
+	}
+	if result.Cover.Source != CoverBody {
+		t.Errorf("cover source = %q, want %q", result.Cover.Source, CoverBody)
+	}
+}
+
+func TestFindPostByTitleAfterBaseline(t *testing.T) {
+	c, _ := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword})
+	ctx := testContext(t)
+
+	const title = "같은 제목"
+	first, err := c.WritePost(ctx, Post{Title: title, Content: "<p>첫 글</p>"})
+	if err != nil {
+		t.Fatalf("first WritePost: %v", err)
+	}
+	baseline, err := c.latestPostID(ctx, title)
+	if err != nil {
+		t.Fatalf("latestPostID: %v", err)
+	}
+	if strconv.Itoa(baseline) != first.PostID {
+		t.Fatalf("baseline = %d, want %s", baseline, first.PostID)
+	}
+
+	// 발행 전부터 있던 글은 이번 발행으로 인정하지 않음
+	if _, err := c.findPostByTitle(ctx, title, baseline); !errors.Is(err, ErrPublishNotConfirmed) {
+		t.Fatalf("findPostByTitle before publish = %v, want ErrPublishNotConfirmed", err)
+	}
+
+	second, err := c.WritePost(ctx, Post{Title: title, Content: "<p>둘째 글</p>"})
+	if err != nil {
+		t.Fatalf("second WritePost: %v", err)
+	}
+	found, err := c.findPostByTitle(ctx, title, baseline)
+	if err != nil {
+		t.Fatalf("findPostByTitle after publish: %v", err)
+	}
+	if found.PostID != second.PostID {
+		t.Errorf("found post %s, want %s", found.PostID, second.PostID)
+	}
+}
+
+func TestWritePostBeforePublish(t *testing.T) {
+	c, srv := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword})
+	ctx := testContext(t)
+
+	const title = "중단된 발행"
+	first, err := c.WritePost(ctx, Post{Title: title, Content: "<p>첫 글</p>"})
+	if err != nil {
+		t.Fatalf("first WritePost: %v", err)
+	}
+
+	// 발행 직전에 같은 제목의 가장 최근 글 ID를 넘겨받음
+	baseline := -1
+	second, err := c.WritePost(ctx, Post{
+		Title:         title,
+		Content:       "<p>둘째 글</p>",
+		BeforePublish: func(id int) error { baseline = id; return nil },
+	})
+	if err != nil {
+		t.Fatalf("second WritePost: %v", err)
+	}
+	if strconv.Itoa(baseline) != first.PostID {
+		t.Fatalf("baseline = %d, want %s", baseline, first.PostID)
+	}
+
+	// 재시작 후 확인: baseline보다 새 글만 이 작업의 글
+	found, err := c.FindPostAfter(ctx, title, baseline)
+	if err != nil {
+		t.Fatalf("FindPostAfter: %v", err)
+	}
+	if found == nil || found.PostID != second.PostID {
+		t.Fatalf("FindPostAfter = %+v, want post %s", found, second.PostID)
+	}
+	id, _ := strconv.Atoi(second.PostID)
+	if found, err := c.FindPostAfter(ctx, title, id); err != nil || found != nil {
+		t.Errorf("FindPostAfter(latest) = %+v, %v, want nil", found, err)
+	}
+
+	// 훅이 실패하면 발행 버튼을 누르지 않음
+	_, err = c.WritePost(ctx, Post{
+		Title:         title,
+		Content:       "<p>셋째 글</p>",
+		BeforePublish: func(int) error { return errors.New("큐 저장 실패") },
+	})
+	if err == nil {
+		t.Fatal("WritePost with failing BeforePublish succeeded")
+	}
+	if n := len(srv.Posts()); n != 2 {
+		t.Errorf("posts = %d, want 2", n)
+	}
+}
+
+func TestConfirmPublishFallback(t *testing.T) {
+	c, _ := newFakeClient(t, fake.Options{Email: testEmail, Password: testPassword})
+	ctx := testContext(t)
+
+	saved := publishConfirmTimeout
+	publishConfirmTimeout = time.Second
+	t.Cleanup(func() { publishConfirmTimeout = saved })
+
+	const title = "응답 없는 발행"
+	posted, err := c.WritePost(ctx, Post{Title: title, Content: "<p>본문</p>"})
+	if err != nil {
+		t.Fatalf("WritePost: %v", err)
+	}
+
+	// 저장 응답을 못 받으면 관리 목록에서 baseline보다 새 글로 확인
+	result, err := c.confirmPublish(ctx, nil, title, PublishOptions{}, 0)
+	if err != nil {
+		t.Fatalf("confirmPublish: %v", err)
+	}
+	if result.PostID != posted.PostID {
+		t.Errorf("confirmed post %s, want %s", result.PostID, posted.PostID)
+	}
+
+	// baseline보다 새 글이 없으면 확인 실패
+	id, _ := strconv.Atoi(posted.PostID)
+	if _, err := c.confirmPublish(ctx, nil, title, PublishOptions{}, id); !errors.Is(err, ErrPublishNotConfirmed) {
+		t.Errorf("confirmPublish with current baseline = %v, want ErrPublishNotConfirmed", err)
+	}
+
+	// 기존 글 조회에 실패했으면(baseline < 0) 관리 목록으로 확인하지 않음
+	if _, err := c.confirmPublish(ctx, nil, title, PublishOptions{}, -1); !errors.Is(err, ErrPublishNotConfirmed) {
+		t.Errorf("confirmPublish without baseline = %v, want ErrPublishNotConfirmed", err)
+	}
+}
@@ -0,0 +1,206 @@
+package tistory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod"
+)
+
+// 에디터 단계별 동작 (셀렉터는 모두 프로필에서 조회)
+
+// maxTags 티스토리 태그 최대 개수
+const maxTags = 10
+
+// setTitle 제목 입력란 값 교체
+func (c *Client) setTitle(page *rod.Page, title string) error {
+	defer observeStep(stepTitle, time.Now())
+	el, err := c.waitFor(page, ActionTitle, 10*time.Second)
+	if err != nil {
+		return fmt.Errorf("제목 입력란을 찾을 수 없습니다: %w", err)
+	}
+	if _, err := el.Eval(setValueJS, title); err != nil {
+		return fmt.Errorf("제목 입력 실패: %w", err)
+	}
+	return nil
+}
+
+// setContent 본문 교체 (TinyMCE API → 에디터 iframe → contenteditable 순)
+func (c *Client) setContent(page *rod.Page, content string) error {
+	defer observeStep(stepContent, time.Now())
+	ok, err := page.Eval(`(content) => {
+		if (typeof tinymce === 'undefined' || !tinymce.activeEditor) return false;
+		const editor = tinymce.activeEditor;
+		editor.setContent(content);
+		editor.fire('change');
+		editor.fire('input');
+		editor.save();
+		return true;
+	}`, content)
+	if err == nil && ok.Value.Bool() {
+		return pause(page.GetContext(), 2*time.Second)
+	}
+
+	if iframe, err := c.find(page, ActionEditorIframe, ""); err == nil {
+		_, err := iframe.Eval(`function (content) {
+			const doc = this.contentDocument || this.contentWindow.document;
+			doc.body.innerHTML = content;
+			doc.body.dispatchEvent(new Event('input', { bubbles: true }));
+		}`, content)
+		if err == nil {
+			return pause(page.GetContext(), 2*time.Second)
+		}
+	}
+
+	body, err := c.find(page, ActionEditorBody, "")
+	if err != nil {
+		return fmt.Errorf("본문 에디터를 찾을 수 없습니다: %w", err)
+	}
+	if _, err := body.Eval(`function (content) {
+		this.innerHTML = content;
+		this.dispatchEvent(new Event('input', { bubbles: true }));
+	}`, content); err != nil {
+		return fmt.Errorf("본문 입력 실패: %w", err)
+	}
+	return pause(page.GetContext(), 2*time.Second)
+}
+
+// normalizeTags 중복 제거 + 최대 개수 제한
+func normalizeTags(tags []string) []string {
+	unique := make([]string, 0, maxTags)
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		key := strings.ToLower(strings.TrimSpace(tag))
+		if key != "" && !seen[key] && len(unique) < maxTags {
+			seen[key] = true
+			unique = append(unique, strings.TrimSpace(tag))
+		}
+	}
+	return unique
+}
+
+// addTags 태그 입력란에 태그를 하나씩 입력 (Enter로 확정)
+// 입력란을 찾지 못한 태그는 경고만 출력하고 계속한다 (에러는 단계 취소/시간 초과뿐).
+func (c *Client) addTags(page *rod.Page, tags []string) error {
+	defer observeStep(stepTags, time.Now())
+	log := pageLog(page)
+	_, _ = page.Eval(`() => window.scrollTo(0, document.body.scrollHeight)`)
+	if err := pause(page.GetContext(), 1*time.Second); err != nil {
+		return err
+	}
+
+	for i, tag := range tags {
+		input, err := c.find(page, ActionTagInput, "")
+		if err == nil {
+			_, err = input.Eval(`function (tag) {
+				this.scrollIntoView({ block: 'center' });
+				this.focus();
+				this.click();
+				const setter = Object.getOwnPropertyDescriptor(HTMLInputElement.prototype, 'value').set;
+				setter.call(this, tag);
+				this.dispatchEvent(new Event('input', { bubbles: true, cancelable: true }));
+				this.dispatchEvent(new Event('change', { bubbles: true, cancelable: true }));
+				const enter = { key: 'Enter', code: 'Enter', keyCode: 13, which: 13, charCode: 13, bubbles: true, cancelable: true };
+				this.dispatchEvent(new KeyboardEvent('keydown', enter));
+				this.dispatchEvent(new KeyboardEvent('keypress', enter));
+				this.dispatchEvent(new KeyboardEvent('keyup', enter));
+			}`, tag)
+		}
+		if err != nil {
+			log.Warn("⚠️ 태그 실패", "tag", tag, "err", err)
+		} else {
+			log.Debug("태그 추가", "tag", tag, "n", fmt.Sprintf("%d/%d", i+1, len(tags)))
+		}
+		if err := pause(page.GetContext(), 800*time.Millisecond); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clearTags 입력된 태그 모두 삭제
+func (c *Client) clearTags(page *rod.Page) int {
+	removed := 0
+	for removed < 50 {
+		if err := c.clickAction(page, ActionTagDelete, ""); err != nil {
+			break
+		}
+		removed++
+		if pause(page.GetContext(), 200*time.Millisecond) != nil {
+			break
+		}
+	}
+	return removed
+}
+
+// selectCategory 카테고리 드롭다운을 열고 이름이 일치하는 항목 선택
+func (c *Client) selectCategory(page *rod.Page, categoryName string) error {
+	defer observeStep(stepCategory, time.Now())
+	log := pageLog(page)
+	log.Debug("📂 카테고리 선택", "name", categoryName)
+
+	if err := c.clickAction(page, ActionCategoryDropdown, ""); err != nil {
+		log.Warn("⚠️ 카테고리 드롭다운을 찾을 수 없음", "err", err)
+	}
+	if err := pause(page.GetContext(), 1*time.Second); err != nil {
+		return err
+	}
+
+	if err := c.clickAction(page, ActionCategoryOption, categoryName); err != nil {
+		return fmt.Errorf("%w: %s", ErrCategoryNotFound, categoryName)
+	}
+	log.Debug("카테고리 선택됨", "name", categoryName)
+	return pause(page.GetContext(), 1*time.Second)
+}
+
+// openPublishLayer 에디터 하단 완료 버튼으로 발행 레이어 열기
+func (c *Client) openPublishLayer(page *rod.Page) error {
+	defer observeStep(stepPublishLayer, time.Now())
+	pageLog(page).Debug("📤 완료 버튼 클릭 시도...")
+	if err := c.clickAction(page, ActionCompleteButton, ""); err != nil {
+		return fmt.Errorf("완료 버튼을 찾을 수 없음: %w", err)
+	}
+	return pause(page.GetContext(), 3*time.Second)
+}
+
+// coverPreviewTimeout 대표이미지 업로드 후 발행 레이어 미리보기를 기다리는 시간
+const coverPreviewTimeout = 10 * time.Second
+
+// setRepresentativeImage 발행 레이어의 대표이미지 옵션으로 업로드 (반환: 미리보기 주소, 미리보기로 확인됐는지)
+// 에러면 대표이미지가 설정되지 않은 것이므로 호출한 쪽은 발행을 계속하고 본문 첫 이미지가 쓰인다.
+func (c *Client) setRepresentativeImage(page *rod.Page, imagePath string) (string, bool, error) {
+	defer observeStep(stepThumbnail, time.Now())
+	log := pageLog(page)
+	log.Debug("🖼️ 대표이미지 추가 시도...")
+
+	input, err := c.find(page, ActionThumbnailInput, "")
+	if err != nil {
+		// 대표이미지 영역을 눌러야 파일 입력이 생기는 경우
+		_ = c.clickAction(page, ActionThumbnailBox, "")
+		if err := pause(page.GetContext(), 1*time.Second); err != nil {
+			return "", false, err
+		}
+		input, err = c.find(page, ActionFileInput, "")
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("대표이미지 입력란을 찾을 수 없음: %w", err)
+	}
+
+	if err := input.SetFiles([]string{imagePath}); err != nil {
+		return "", false, fmt.Errorf("대표이미지 파일 설정 실패: %w", err)
+	}
+
+	// 업로드가 끝나면 대표이미지 영역에 미리보기가 나타남 (없어도 업로드는 된 것으로 봄)
+	preview, err := c.waitFor(page, ActionThumbnailPreview, coverPreviewTimeout)
+	if err != nil {
+		log.Warn("⚠️ 대표이미지 미리보기 확인 안 됨", "err", err)
+		return "", false, nil
+	}
+	src, _ := preview.Attribute("src")
+	log.Info("✅ 대표이미지 업로드 완료!")
+	if src == nil {
+		return "", true, nil
+	}
+	return *src, true, nil
+}
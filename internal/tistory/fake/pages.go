@@ -0,0 +1,313 @@
+package fake
+
+import (
+	"html/template"
+	"net/http"
+)
+
+// category 블로그 카테고리
+type category struct {
+	ID   int
+	Name string
+}
+
+// editorData 에디터 페이지 데이터 (Post가 있으면 수정 모드)
+type editorData struct {
+	Categories []category
+	Endpoint   string
+	Post       *Submission
+}
+
+// render 템플릿 출력
+func render(w http.ResponseWriter, tmpl *template.Template, data interface{}) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := tmpl.Execute(w, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// loginLandingTmpl 티스토리 로그인 첫 화면 (카카오 로그인 버튼)
+var loginLandingTmpl = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html lang="ko"><head><meta charset="utf-8"><title>Tistory 로그인</title></head>
+<body>
+<div class="login_tistory">
+<a class="link_kakao_id" href="/auth/login/kakao">카카오계정으로 로그인</a>
+</div>
+</body></html>
+`))
+
+// kakaoFormTmpl 카카오 로그인 폼 (데이터 = 에러 메시지)
+var kakaoFormTmpl = template.Must(template.New("kakao").Parse(`<!DOCTYPE html>
+<html lang="ko"><head><meta charset="utf-8"><title>카카오계정</title></head>
+<body>
+<form method="post" action="/auth/login/kakao">
+{{if .}}<p class="desc_error">{{.}}</p>{{end}}
+<input type="text" name="loginId" placeholder="카카오메일 아이디, 이메일, 전화번호">
+<input type="password" name="password" placeholder="비밀번호">
+<button type="submit" class="btn_g highlight submit">로그인</button>
+</form>
+</body></html>
+`))
+
+// captchaTmpl 로그인 폼 제출 후 보안문자 화면 (Options.Captcha)
+var captchaTmpl = template.Must(template.New("captcha").Parse(`<!DOCTYPE html>
+<html lang="ko"><head><meta charset="utf-8"><title>카카오계정</title></head>
+<body>
+<div class="captcha_box">
+<img src="data:image/gif;base64,R0lGODlhAQABAAAAACw=" alt="보안문자">
+<input type="text" name="captcha" placeholder="보안문자 입력">
+</div>
+</body></html>
+`))
+
+// homeTmpl 로그인 후 이동하는 블로그 첫 화면
+var homeTmpl = template.Must(template.New("home").Parse(`<!DOCTYPE html>
+<html lang="ko"><head><meta charset="utf-8"><title>fake blog</title></head>
+<body><h1>fake tistory</h1><a href="/manage/newpost">글쓰기</a></body></html>
+`))
+
+// entryTmpl 발행된 글 페이지
+var entryTmpl = template.Must(template.New("entry").Parse(`<!DOCTYPE html>
+<html lang="ko"><head><meta charset="utf-8"><title>{{.Title}}</title></head>
+<body>
+<div class="category">{{.Category}}</div>
+<h1 class="title">{{.Title}}</h1>
+<div class="contents_style">{{.Content}}</div>
+<div class="tags">{{range .Tags}}<span>#{{.}}</span>{{end}}</div>
+</body></html>
+`))
+
+// manageListTmpl 글 관리 목록
+var manageListTmpl = template.Must(template.New("manage").Parse(`<!DOCTYPE html>
+<html lang="ko"><head><meta charset="utf-8"><title>글 관리</title></head>
+<body>
+<ul class="list_post">
+{{range .}}<li class="post_cont">
+<strong class="tit_post"><a href="/{{.PostID}}">{{.Title}}</a></strong>
+<span class="txt_cate">{{.Category}}</span>
+<span class="txt_state">{{.Visibility}}</span>
+<span class="txt_date">{{.SubmittedAt.Format "2006. 1. 2. 15:04"}}</span>
+<a href="/manage/post/{{.PostID}}">수정</a>
+<button type="button" class="btn_delete" data-id="{{.PostID}}">삭제</button>
+</li>
+{{end}}</ul>
+<script>
+document.querySelectorAll('.btn_delete').forEach((btn) => {
+	btn.addEventListener('click', () => {
+		if (!confirm('글을 삭제하시겠습니까?')) return;
+		fetch('/manage/post/' + btn.dataset.id + '/delete', { method: 'POST' })
+			.then(() => location.reload());
+	});
+});
+</script>
+</body></html>
+`))
+
+// editorTmpl 글쓰기/수정 에디터
+// TinyMCE 흉내: window.tinymce.activeEditor + iframe#tinymce_ifr 의 contenteditable body
+var editorTmpl = template.Must(template.New("editor").Parse(`<!DOCTYPE html>
+<html lang="ko"><head><meta charset="utf-8"><title>글쓰기</title>
+<style>
+#category-list, #publish-layer, #reserve-box { display: none; }
+#category-list.open, #publish-layer.open, #reserve-box.open { display: block; }
+</style>
+</head>
+<body>
+<div class="editor_wrap">
+	<button type="button" id="category-btn">{{if .Post}}{{.Post.Category}}{{else}}카테고리{{end}}</button>
+	<ul id="category-list">
+		{{range .Categories}}<li role="option" class="category-item" data-id="{{.ID}}">{{.Name}}</li>{{end}}
+	</ul>
+	<select id="category" hidden>
+		<option value="">카테고리 없음</option>
+		{{range .Categories}}<option value="{{.ID}}">{{.Name}}</option>{{end}}
+	</select>
+
+	<input type="text" id="post-title-inp" placeholder="제목을 입력하세요" value="{{if .Post}}{{.Post.Title}}{{end}}">
+
+	<div class="mce-toolbar">
+		<button type="button" id="mceu_0-open" aria-label="첨부"><i class="mce-ico mce-i-image"></i></button>
+		<input type="file" id="attach-file" accept="image/*" hidden>
+	</div>
+	<iframe id="tinymce_ifr"></iframe>
+
+	<div class="tag-area">
+		<div class="tag-list"></div>
+		<input type="text" class="tf_g" placeholder="태그입력">
+	</div>
+
+	<div class="editor_btns">
+		<button type="button" class="btn-draft">임시저장</button>
+		<button type="button" class="btn-publish">완료</button>
+	</div>
+	<p id="draft-status"></p>
+</div>
+
+<div id="publish-layer" class="layer_post">
+	<div class="box_thumb">
+		<span class="txt_thumb">대표이미지 추가</span>
+		<input type="file" class="inp_g" accept="image/*">
+	</div>
+	<div class="visibility">
+		<input type="radio" name="visibility" id="open20" value="공개" checked><label for="open20">공개</label>
+		<input type="radio" name="visibility" id="open15" value="보호"><label for="open15">보호</label>
+		<input type="radio" name="visibility" id="open0" value="비공개"><label for="open0">비공개</label>
+	</div>
+	<input type="password" id="post-password" placeholder="비밀번호">
+	<button type="button" id="reserve-btn">예약</button>
+	<div id="reserve-box">
+		<input type="date" name="reserve_date">
+		<input type="text" name="reserve_hour" class="inp_hour">
+		<input type="text" name="reserve_minute" class="inp_minute">
+	</div>
+	<button type="button" id="publish-btn">공개 발행</button>
+</div>
+
+<script>
+const endpoint = {{.Endpoint}};
+const initialContent = {{if .Post}}{{.Post.Content}}{{else}}""{{end}};
+const initialTags = {{if .Post}}{{.Post.Tags}}{{else}}[]{{end}};
+const state = {
+	category: {{if .Post}}{{.Post.Category}}{{else}}""{{end}},
+	tags: [],
+	thumbnail: "",
+	reserve: false,
+};
+
+// 에디터 본문 (iframe + tinymce 흉내)
+const iframe = document.getElementById('tinymce_ifr');
+const doc = iframe.contentDocument;
+doc.open();
+doc.write('<html><body class="mce-content-body" contenteditable="true"></body></html>');
+doc.close();
+doc.body.innerHTML = initialContent;
+window.tinymce = {
+	activeEditor: {
+		setContent: (html) => { doc.body.innerHTML = html; },
+		getContent: () => doc.body.innerHTML,
+		fire: () => {},
+		save: () => {},
+	},
+};
+
+// 카테고리 드롭다운
+const categoryBtn = document.getElementById('category-btn');
+const categoryList = document.getElementById('category-list');
+categoryBtn.addEventListener('click', () => categoryList.classList.toggle('open'));
+categoryList.querySelectorAll('[role="option"]').forEach((opt) => {
+	opt.addEventListener('click', () => {
+		state.category = opt.textContent.trim();
+		categoryBtn.textContent = state.category;
+		categoryList.classList.remove('open');
+	});
+});
+
+// 태그 입력 (Enter로 확정)
+const tagList = document.querySelector('.tag-list');
+const tagInput = document.querySelector('.tf_g');
+const addTag = (tag) => {
+	if (!tag || state.tags.includes(tag)) return;
+	state.tags.push(tag);
+	const item = document.createElement('span');
+	item.className = 'txt_tag';
+	item.textContent = '#' + tag;
+	const del = document.createElement('button');
+	del.type = 'button';
+	del.className = 'btn_delete';
+	del.textContent = 'x';
+	del.addEventListener('click', () => {
+		state.tags = state.tags.filter((t) => t !== tag);
+		item.remove();
+	});
+	item.appendChild(del);
+	tagList.appendChild(item);
+};
+(initialTags || []).forEach(addTag);
+tagInput.addEventListener('keydown', (e) => {
+	if (e.key !== 'Enter') return;
+	e.preventDefault();
+	addTag(tagInput.value.trim());
+	tagInput.value = '';
+});
+
+// 업로드 (본문 첨부 / 대표이미지)
+const upload = (file, field) => {
+	const form = new FormData();
+	form.append('file', file);
+	form.append('field', field);
+	return fetch('/manage/upload', { method: 'POST', body: form }).then((r) => r.json());
+};
+const attachInput = document.getElementById('attach-file');
+document.getElementById('mceu_0-open').addEventListener('click', () => attachInput.click());
+attachInput.addEventListener('change', () => {
+	if (!attachInput.files.length) return;
+	upload(attachInput.files[0], 'attach').then((res) => {
+		doc.body.insertAdjacentHTML('beforeend', '<p><img src="' + res.url + '"></p>');
+	});
+});
+const thumbInput = document.querySelector('.inp_g');
+thumbInput.addEventListener('change', () => {
+	if (!thumbInput.files.length) return;
+	upload(thumbInput.files[0], 'thumbnail').then((res) => {
+		state.thumbnail = res.filename;
+		document.querySelector('.box_thumb').insertAdjacentHTML('beforeend', '<img class="img_thumb" src="' + res.url + '">');
+	});
+});
+
+// 제출 데이터
+const collect = () => {
+	const visibility = document.querySelector('input[name="visibility"]:checked').value;
+	const data = {
+		title: document.getElementById('post-title-inp').value,
+		content: doc.body.innerHTML,
+		category: state.category,
+		tags: state.tags,
+		visibility: visibility,
+		password: visibility === '보호' ? document.getElementById('post-password').value : '',
+		reserveAt: '',
+		thumbnail: state.thumbnail,
+	};
+	if (state.reserve) {
+		const q = (n) => document.querySelector('[name="' + n + '"]').value;
+		data.reserveAt = q('reserve_date') + ' ' + q('reserve_hour') + ':' + q('reserve_minute');
+	}
+	return data;
+};
+const post = (url, data) => fetch(url, {
+	method: 'POST',
+	headers: { 'Content-Type': 'application/json' },
+	body: JSON.stringify(data),
+});
+
+// 임시저장
+document.querySelector('.btn-draft').addEventListener('click', () => {
+	post('/manage/drafts.json', collect()).then(() => {
+		document.getElementById('draft-status').textContent = '임시저장 완료';
+	});
+});
+
+// 완료 → 발행 레이어
+const layer = document.getElementById('publish-layer');
+const publishBtn = document.getElementById('publish-btn');
+document.querySelector('.btn-publish').addEventListener('click', () => layer.classList.add('open'));
+const updatePublishLabel = () => {
+	const v = document.querySelector('input[name="visibility"]:checked').value;
+	const action = state.reserve ? '예약 발행' : (v === '비공개' ? '저장' : '발행');
+	publishBtn.textContent = v + ' ' + action;
+};
+document.querySelectorAll('input[name="visibility"]').forEach((r) => r.addEventListener('change', updatePublishLabel));
+document.getElementById('reserve-btn').addEventListener('click', () => {
+	state.reserve = true;
+	document.getElementById('reserve-box').classList.add('open');
+	updatePublishLabel();
+});
+
+// 발행: 응답을 받은 뒤 관리 목록으로 이동
+publishBtn.addEventListener('click', () => {
+	post(endpoint, collect())
+		.then((r) => r.json())
+		.then(() => setTimeout(() => { location.href = '/manage/posts/'; }, 1500));
+});
+</script>
+</body></html>
+`))
@@ -0,0 +1,399 @@
+// Package fake 티스토리 로그인/에디터/관리 페이지의 최소 복제 서버
+//
+// rod 클라이언트를 실제 사이트 없이 헤드리스로 검증하기 위한 로컬 HTTP 서버로,
+// 카카오 로그인 폼, TinyMCE 흉내 에디터(/manage/newpost, /manage/post/{id}),
+// 카테고리 드롭다운, 태그 입력, 발행 레이어(공개 범위/예약/대표이미지), 첨부 업로드,
+// 글 관리 목록을 제공하고 제출된 내용을 기록한다.
+//
+//	srv := fake.NewServer(fake.Options{Email: "a@b.c", Password: "pw", Categories: []string{"골프"}})
+//	defer srv.Close()
+//	client := tistory.NewClient("a@b.c", "pw", "fake", true, 0)
+//	client.SetBaseURL(srv.URL)
+//	result, err := client.WritePost(ctx, tistory.Post{Title: "제목", Content: "<p>본문</p>", Category: "골프"})
+//	posts := srv.Posts()
+package fake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sessionCookie 로그인 세션 쿠키 이름
+const sessionCookie = "fake_tistory_session"
+
+// Options 가짜 서버 설정
+type Options struct {
+	Email      string   // 로그인 허용 이메일
+	Password   string   // 로그인 허용 비밀번호
+	Categories []string // 블로그 카테고리 이름 (ID는 1부터 순서대로)
+	Captcha    bool     // 로그인 폼을 제출하면 항상 보안문자 화면 (ErrCaptcha 재현용)
+}
+
+// Submission 에디터에서 제출된 글 1건
+type Submission struct {
+	PostID      int       `json:"postId"`
+	Title       string    `json:"title"`
+	Content     string    `json:"content"`
+	Category    string    `json:"category"`
+	Tags        []string  `json:"tags"`
+	Visibility  string    `json:"visibility"` // 공개 / 보호 / 비공개
+	Password    string    `json:"password"`
+	ReserveAt   string    `json:"reserveAt"` // "2006-01-02 15:04" (빈 값 = 즉시)
+	Thumbnail   string    `json:"thumbnail"` // 대표이미지 업로드 파일 이름
+	Draft       bool      `json:"-"`
+	Deleted     bool      `json:"-"`
+	SubmittedAt time.Time `json:"-"`
+}
+
+// Upload 업로드된 파일 1건
+type Upload struct {
+	Field       string // "attach" (본문 첨부) / "thumbnail" (대표이미지)
+	Name        string
+	Size        int
+	ContentType string
+}
+
+// Server 가짜 티스토리 서버
+type Server struct {
+	*httptest.Server
+
+	opts Options
+
+	mu            sync.Mutex
+	sessions      map[string]bool
+	posts         []*Submission
+	drafts        []Submission
+	uploads       []Upload
+	nextID        int
+	loginAttempts int
+}
+
+// NewServer 가짜 서버 시작 (사용 후 Close)
+func NewServer(opts Options) *Server {
+	s := &Server{
+		opts:     opts,
+		sessions: make(map[string]bool),
+		nextID:   1,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /auth/login", s.handleLoginLanding)
+	mux.HandleFunc("GET /auth/login/kakao", s.handleKakaoForm)
+	mux.HandleFunc("POST /auth/login/kakao", s.handleKakaoSubmit)
+	mux.HandleFunc("GET /manage/newpost", s.requireLogin(s.handleNewPost))
+	mux.HandleFunc("GET /manage/post/{id}", s.requireLogin(s.handleEditPost))
+	mux.HandleFunc("POST /manage/post.json", s.requireLogin(s.handleCreate))
+	mux.HandleFunc("POST /manage/post/{file}", s.requireLogin(s.handleUpdate))
+	mux.HandleFunc("POST /manage/post/{id}/delete", s.requireLogin(s.handleDelete))
+	mux.HandleFunc("POST /manage/drafts.json", s.requireLogin(s.handleDraft))
+	mux.HandleFunc("POST /manage/upload", s.requireLogin(s.handleUpload))
+	mux.HandleFunc("GET /manage/posts/", s.requireLogin(s.handleManageList))
+	mux.HandleFunc("GET /{id}", s.handleEntry)
+	mux.HandleFunc("GET /{$}", s.handleHome)
+
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Posts 발행된 글 목록 (삭제된 글 포함, 복사본)
+func (s *Server) Posts() []Submission {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	posts := make([]Submission, len(s.posts))
+	for i, p := range s.posts {
+		posts[i] = *p
+		posts[i].Tags = append([]string(nil), p.Tags...)
+	}
+	return posts
+}
+
+// Drafts 임시저장된 글 목록
+func (s *Server) Drafts() []Submission {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Submission(nil), s.drafts...)
+}
+
+// Uploads 업로드된 파일 목록
+func (s *Server) Uploads() []Upload {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Upload(nil), s.uploads...)
+}
+
+// LoginAttempts 로그인 폼 제출 횟수 (세션 재사용 확인용)
+func (s *Server) LoginAttempts() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.loginAttempts
+}
+
+// ExpireSessions 모든 세션 만료 (ErrSessionExpired 재현용)
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = make(map[string]bool)
+}
+
+// requireLogin 세션이 없으면 로그인 페이지로 리다이렉트
+func (s *Server) requireLogin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(sessionCookie)
+		s.mu.Lock()
+		ok := err == nil && s.sessions[cookie.Value]
+		s.mu.Unlock()
+		if !ok {
+			if r.Method == http.MethodGet {
+				http.Redirect(w, r, "/auth/login?redirectUrl="+r.URL.Path, http.StatusFound)
+				return
+			}
+			http.Error(w, `{"error":"login required"}`, http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *Server) handleLoginLanding(w http.ResponseWriter, r *http.Request) {
+	render(w, loginLandingTmpl, nil)
+}
+
+func (s *Server) handleKakaoForm(w http.ResponseWriter, r *http.Request) {
+	render(w, kakaoFormTmpl, "")
+}
+
+func (s *Server) handleKakaoSubmit(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.loginAttempts++
+	s.mu.Unlock()
+
+	if s.opts.Captcha {
+		render(w, captchaTmpl, nil)
+		return
+	}
+	if r.FormValue("loginId") != s.opts.Email || r.FormValue("password") != s.opts.Password {
+		w.WriteHeader(http.StatusUnauthorized)
+		render(w, kakaoFormTmpl, "계정 정보가 일치하지 않습니다.")
+		return
+	}
+
+	token := newToken()
+	s.mu.Lock()
+	s.sessions[token] = true
+	s.mu.Unlock()
+
+	http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: token, Path: "/", HttpOnly: true})
+	http.Redirect(w, r, "/", http.StatusFound)
+}
+
+func (s *Server) handleHome(w http.ResponseWriter, r *http.Request) {
+	render(w, homeTmpl, nil)
+}
+
+func (s *Server) handleNewPost(w http.ResponseWriter, r *http.Request) {
+	render(w, editorTmpl, editorData{
+		Categories: s.categories(),
+		Endpoint:   "/manage/post.json",
+	})
+}
+
+func (s *Server) handleEditPost(w http.ResponseWriter, r *http.Request) {
+	post := s.lookup(r.PathValue("id"))
+	if post == nil || post.Deleted {
+		http.NotFound(w, r)
+		return
+	}
+	render(w, editorTmpl, editorData{
+		Categories: s.categories(),
+		Endpoint:   fmt.Sprintf("/manage/post/%d.json", post.PostID),
+		Post:       post,
+	})
+}
+
+// handleCreate 새 글 발행 (/manage/post.json)
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request) {
+	var sub Submission
+	if err := json.NewDecoder(r.Body).Decode(&sub); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	sub.PostID = s.nextID
+	sub.SubmittedAt = time.Now()
+	s.nextID++
+	s.posts = append(s.posts, &sub)
+	s.mu.Unlock()
+
+	s.writeEntry(w, sub.PostID)
+}
+
+// handleUpdate 글 수정 (/manage/post/{id}.json)
+func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request) {
+	file := r.PathValue("file")
+	if !strings.HasSuffix(file, ".json") {
+		http.NotFound(w, r)
+		return
+	}
+	id := strings.TrimSuffix(file, ".json")
+
+	var sub Submission
+	if err := json.NewDecoder(r.Body).Decode(&sub); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.posts {
+		if strconv.Itoa(p.PostID) == id && !p.Deleted {
+			sub.PostID = p.PostID
+			sub.SubmittedAt = time.Now()
+			*p = sub
+			s.writeEntryLocked(w, p.PostID)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// handleDelete 글 삭제 (관리 목록의 삭제 버튼)
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.posts {
+		if strconv.Itoa(p.PostID) == id {
+			p.Deleted = true
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"ok":true}`)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// handleDraft 임시저장
+func (s *Server) handleDraft(w http.ResponseWriter, r *http.Request) {
+	var sub Submission
+	if err := json.NewDecoder(r.Body).Decode(&sub); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	sub.Draft = true
+	sub.SubmittedAt = time.Now()
+
+	s.mu.Lock()
+	s.drafts = append(s.drafts, sub)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(w, `{"ok":true}`)
+}
+
+// handleUpload 첨부/대표이미지 업로드 (multipart "file")
+func (s *Server) handleUpload(w http.ResponseWriter, r *http.Request) {
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	n, _ := io.Copy(io.Discard, file)
+	up := Upload{
+		Field:       r.FormValue("field"),
+		Name:        header.Filename,
+		Size:        int(n),
+		ContentType: header.Header.Get("Content-Type"),
+	}
+
+	s.mu.Lock()
+	s.uploads = append(s.uploads, up)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"url":      "/attach/" + up.Name,
+		"filename": up.Name,
+	})
+}
+
+func (s *Server) handleManageList(w http.ResponseWriter, r *http.Request) {
+	keyword := r.URL.Query().Get("searchKeyword")
+	s.mu.Lock()
+	var posts []Submission
+	for i := len(s.posts) - 1; i >= 0; i-- {
+		p := s.posts[i]
+		if p.Deleted || (keyword != "" && !strings.Contains(p.Title, keyword)) {
+			continue
+		}
+		posts = append(posts, *p)
+	}
+	s.mu.Unlock()
+
+	render(w, manageListTmpl, posts)
+}
+
+func (s *Server) handleEntry(w http.ResponseWriter, r *http.Request) {
+	post := s.lookup(r.PathValue("id"))
+	if post == nil || post.Deleted {
+		http.NotFound(w, r)
+		return
+	}
+	render(w, entryTmpl, post)
+}
+
+// writeEntry 발행 응답 ({"entryUrl": ..., "postId": ...})
+func (s *Server) writeEntry(w http.ResponseWriter, id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeEntryLocked(w, id)
+}
+
+func (s *Server) writeEntryLocked(w http.ResponseWriter, id int) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"entryUrl": fmt.Sprintf("%s/%d", s.URL, id),
+		"postId":   id,
+	})
+}
+
+// lookup 글 ID로 조회 (복사본)
+func (s *Server) lookup(id string) *Submission {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.posts {
+		if strconv.Itoa(p.PostID) == id {
+			post := *p
+			return &post
+		}
+	}
+	return nil
+}
+
+// categories 카테고리 목록 (ID는 1부터)
+func (s *Server) categories() []category {
+	cats := make([]category, len(s.opts.Categories))
+	for i, name := range s.opts.Categories {
+		cats[i] = category{ID: i + 1, Name: name}
+	}
+	return cats
+}
+
+// newToken 세션 토큰 생성
+func newToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
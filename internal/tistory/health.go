@@ -0,0 +1,50 @@
+package tistory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/metrics"
+)
+
+// 발행 단계 이름 (tistory_bot_publish_step_duration_seconds의 step 라벨)
+const (
+	stepLogin        = "login"         // 세션 확인 + 로그인
+	stepEditorLoad   = "editor_load"   // 글쓰기 페이지 로딩 + 세션 확인
+	stepDraftDialog  = "draft_dialog"  // 에디터 안정화 대기 + 임시저장 복구 안내 닫기
+	stepTitle        = "title"         // 제목 입력
+	stepImages       = "images"        // 본문 이미지 받기 + 첨부 업로드
+	stepContent      = "content"       // 본문 입력
+	stepTags         = "tags"          // 태그 입력
+	stepCategory     = "category"      // 카테고리 선택
+	stepDraft        = "draft"         // 임시저장
+	stepBaseline     = "baseline"      // 발행 전 같은 제목 기존 글 조회 (발행 확인용)
+	stepPublishLayer = "publish_layer" // 완료 버튼 → 발행 레이어
+	stepThumbnail    = "thumbnail"     // 대표이미지 업로드
+	stepOptions      = "options"       // 공개 범위/예약 설정
+	stepPublish      = "publish"       // 발행 버튼 클릭
+	stepConfirm      = "confirm"       // 발행 확인 (응답 또는 관리 목록)
+)
+
+// observeStep 발행 단계 소요 시간 기록 (실패한 단계 포함, defer observeStep(단계, time.Now()))
+func observeStep(step string, start time.Time) {
+	metrics.PublishStep.Observe(time.Since(start).Seconds(), step)
+}
+
+// Ping 브라우저가 응답하는지 확인 (헬스 체크용, 다른 작업과 동시에 호출 가능)
+func (c *Client) Ping(ctx context.Context) error {
+	c.mu.Lock()
+	browser := c.browser
+	c.mu.Unlock()
+	if browser == nil {
+		return errors.New("브라우저 없음")
+	}
+	_, err := browser.Context(ctx).Version()
+	return err
+}
+
+// LoggedIn 로그인된 세션인지 (세션 만료가 확인되면 false, 다른 작업과 동시에 호출 가능)
+func (c *Client) LoggedIn() bool {
+	return c.loggedIn.Load()
+}
@@ -0,0 +1,192 @@
+package tistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/media"
+	"github.com/go-rod/rod"
+)
+
+// 본문 이미지 업로드 (외부/로컬 이미지 → 에디터 첨부 → 티스토리 주소로 교체)
+
+const (
+	maxImages          = 20               // 글 1개에 업로드하는 최대 이미지 수 (나머지는 원래 주소 유지)
+	imageUploadTimeout = 30 * time.Second // 첨부 후 에디터에 이미지가 들어올 때까지 대기
+)
+
+// hostedImageHosts 이미 티스토리에 올라간 이미지 주소 (다시 올리지 않음)
+var hostedImageHosts = []string{"kakaocdn.net", "daumcdn.net", "tistory.com"}
+
+// SetImages 본문 이미지 캐시 지정 (nil = 본문 이미지 주소를 그대로 둠)
+func (c *Client) SetImages(cache *media.Cache) {
+	c.images = cache
+}
+
+// hostedImage 업로드하지 않을 이미지인지 (티스토리/가짜 서버 주소, data URI)
+func (c *Client) hostedImage(src string) bool {
+	if strings.HasPrefix(src, "data:") || (c.baseURL != "" && strings.HasPrefix(src, c.baseURL)) {
+		return true
+	}
+	for _, host := range hostedImageHosts {
+		if strings.Contains(src, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// attachImages 본문의 외부/로컬 이미지를 받아 에디터 첨부로 업로드하고 <img src>를 티스토리 주소로 교체
+// 받거나 올리지 못한 이미지는 경고만 남기고 원래 주소를 유지한다 (ctx 취소만 에러).
+func (c *Client) attachImages(ctx context.Context, page *rod.Page, content string) (string, error) {
+	if c.images == nil {
+		return content, nil
+	}
+	defer observeStep(stepImages, time.Now())
+	log := pageLog(page)
+
+	var srcs []string
+	for _, src := range media.Sources(content) {
+		switch {
+		case c.hostedImage(src):
+		case !c.images.Supported(src):
+			log.Debug("⏭️ 받지 않는 이미지 주소, 원래 주소 유지", "src", src)
+		default:
+			srcs = append(srcs, src)
+		}
+	}
+	if len(srcs) > maxImages {
+		log.Warn("⚠️ 이미지가 너무 많아 일부만 업로드", "images", len(srcs), "max", maxImages)
+		srcs = srcs[:maxImages]
+	}
+
+	urls := make(map[string]string, len(srcs))
+	for _, src := range srcs {
+		path, err := c.images.Fetch(ctx, src)
+		if err != nil {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			log.Warn("⚠️ 이미지 받기 실패, 원래 주소 유지", "src", src, "err", err)
+			continue
+		}
+		hosted, err := c.uploadImage(ctx, page, path)
+		if err != nil {
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+			log.Warn("⚠️ 이미지 업로드 실패, 원래 주소 유지", "src", src, "err", err)
+			continue
+		}
+		log.Debug("📷 이미지 업로드", "src", src, "url", hosted)
+		urls[src] = hosted
+	}
+	if len(srcs) > 0 {
+		log.Info("📷 본문 이미지 업로드", "uploaded", len(urls), "total", len(srcs))
+	}
+	return media.Rewrite(content, urls), nil
+}
+
+// uploadImage 에디터 첨부(이미지) 버튼으로 파일을 올리고 에디터에 들어온 이미지 주소 반환
+func (c *Client) uploadImage(ctx context.Context, page *rod.Page, path string) (string, error) {
+	before, err := c.editorImages(page)
+	if err != nil {
+		return "", err
+	}
+
+	// 티스토리 에디터의 이미지(첨부) 버튼 클릭
+	if err := c.clickAction(page, ActionImageButton, ""); err != nil {
+		return "", fmt.Errorf("이미지 버튼을 찾을 수 없음: %w", err)
+	}
+	if err := pause(ctx, 500*time.Millisecond); err != nil {
+		return "", err
+	}
+
+	// 파일 input 찾아서 파일 설정
+	fileInput, err := c.find(page, ActionFileInput, "")
+	if err != nil {
+		return "", fmt.Errorf("파일 업로드 요소를 찾을 수 없음: %w", err)
+	}
+	if err := fileInput.SetFiles([]string{path}); err != nil {
+		return "", fmt.Errorf("파일 설정 실패: %w", err)
+	}
+
+	// 업로드가 끝나면 에디터 본문에 새 이미지가 들어옴
+	seen := make(map[string]bool, len(before))
+	for _, src := range before {
+		seen[src] = true
+	}
+	deadline := time.Now().Add(imageUploadTimeout)
+	for time.Now().Before(deadline) {
+		if err := pause(ctx, 500*time.Millisecond); err != nil {
+			return "", err
+		}
+		after, err := c.editorImages(page)
+		if err != nil {
+			continue
+		}
+		for _, src := range after {
+			if !seen[src] && !strings.HasPrefix(src, "blob:") && !strings.HasPrefix(src, "data:") {
+				return src, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("이미지 업로드 응답 없음 (%s 대기)", imageUploadTimeout)
+}
+
+// prependImage 이미지를 에디터 첨부로 올려 본문 맨 앞에 넣음 (실패하면 경고만, ctx 취소만 에러)
+func (c *Client) prependImage(ctx context.Context, page *rod.Page, content, path string) (string, error) {
+	defer observeStep(stepImages, time.Now())
+	hosted, err := c.uploadImage(ctx, page, path)
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		pageLog(page).Warn("⚠️ 썸네일 본문 첨부 실패", "err", err)
+		return content, nil
+	}
+	return `<p><img src="` + html.EscapeString(hosted) + `" alt=""></p>` + content, nil
+}
+
+// bodyCover 대표이미지를 따로 정하지 않았을 때 티스토리가 쓰는 본문 첫 이미지
+func bodyCover(content string) Cover {
+	if srcs := media.Sources(content); len(srcs) > 0 {
+		return Cover{Source: CoverBody, Image: srcs[0]}
+	}
+	return Cover{}
+}
+
+// editorImagesJS 에디터 본문 이미지 주소 (this = 에디터 iframe, 절대 주소)
+const editorImagesJS = `function () {
+	let body = null;
+	if (typeof tinymce !== 'undefined' && tinymce.activeEditor) {
+		body = tinymce.activeEditor.getBody();
+	} else if (this && this.contentDocument) {
+		body = this.contentDocument.body;
+	}
+	return body ? Array.from(body.querySelectorAll('img')).map((img) => img.src) : null;
+}`
+
+// editorImages 에디터 본문에 있는 이미지 주소 목록
+func (c *Client) editorImages(page *rod.Page) ([]string, error) {
+	iframe, err := c.find(page, ActionEditorIframe, "")
+	if err != nil {
+		return nil, fmt.Errorf("본문 에디터를 찾을 수 없습니다: %w", err)
+	}
+	res, err := iframe.Eval(editorImagesJS)
+	if err != nil {
+		return nil, fmt.Errorf("에디터 이미지 조회 실패: %w", err)
+	}
+	if res.Value.Nil() {
+		return nil, errors.New("에디터 본문 없음")
+	}
+	var srcs []string
+	for _, v := range res.Value.Arr() {
+		srcs = append(srcs, v.Str())
+	}
+	return srcs, nil
+}
@@ -0,0 +1,308 @@
+package tistory
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// PostFilter 글 목록 조회 조건
+type PostFilter struct {
+	Category string // 카테고리 이름 (빈 값 = 전체, 부분 일치)
+	Search   string // 제목 검색어
+	Page     int    // 관리 목록 페이지 (1부터)
+}
+
+// PostSummary 관리 목록의 글 정보
+type PostSummary struct {
+	PostID     string
+	Title      string
+	Category   string
+	Visibility string // 공개 / 보호 / 비공개
+	Date       string // 관리 목록 표기 그대로
+	URL        string
+}
+
+// PostUpdate 글 수정 내용 (nil = 변경 안 함)
+type PostUpdate struct {
+	Title    *string
+	Content  *string
+	Category *string         // 티스토리 카테고리 이름
+	Tags     []string        // nil이 아니면 기존 태그를 모두 교체
+	Publish  *PublishOptions // nil이면 기존 공개 범위 유지
+}
+
+// ListPosts 관리 페이지 글 목록 조회
+func (c *Client) ListPosts(ctx context.Context, filter PostFilter) ([]PostSummary, error) {
+	if !c.loggedIn.Load() {
+		if err := c.Login(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	page, closePage, err := c.openManagePage(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer closePage()
+
+	result, err := page.Eval(`() => {
+		const posts = [];
+		const seen = new Set();
+		const links = document.querySelectorAll('a[href*="/manage/post/"], a[href*="/manage/newpost/"]');
+		for (const link of links) {
+			const m = link.getAttribute('href').match(/\/manage\/(?:new)?post\/(\d+)/);
+			if (!m || seen.has(m[1])) continue;
+			seen.add(m[1]);
+
+			const row = link.closest('li, tr, .post_cont, [class*="post"]') || link.parentElement;
+			const text = (sel) => {
+				const el = row.querySelector(sel);
+				return el ? el.textContent.trim() : '';
+			};
+			const titleEl = row.querySelector('.tit_post a, .tit_post, strong a, strong');
+			posts.push({
+				id: m[1],
+				title: titleEl ? titleEl.textContent.trim() : '',
+				category: text('.txt_cate, [class*="category"]'),
+				visibility: text('.txt_state, [class*="state"], [class*="open"]'),
+				date: text('.txt_date, [class*="date"]'),
+			});
+		}
+		return posts;
+	}`)
+	if err != nil {
+		return nil, fmt.Errorf("글 목록 파싱 실패: %w", err)
+	}
+
+	var posts []PostSummary
+	for _, item := range result.Value.Arr() {
+		m := item.Map()
+		post := PostSummary{
+			PostID:     m["id"].String(),
+			Title:      m["title"].String(),
+			Category:   m["category"].String(),
+			Visibility: m["visibility"].String(),
+			Date:       m["date"].String(),
+		}
+		post.URL = c.blogURL("/" + post.PostID)
+
+		if filter.Category != "" && !strings.Contains(post.Category, filter.Category) {
+			continue
+		}
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
+
+// UpdatePost 기존 글 수정 (/manage/post/{id} 에디터)
+func (c *Client) UpdatePost(ctx context.Context, postID string, upd PostUpdate) (*PostResult, error) {
+	if err := validatePostID(postID); err != nil {
+		return nil, err
+	}
+	if upd.Publish != nil {
+		if err := upd.Publish.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if !c.loggedIn.Load() {
+		if err := c.Login(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	opts := PublishOptions{}
+	if upd.Publish != nil {
+		opts = *upd.Publish
+	}
+
+	// 발행 파이프라인 단계를 바뀐 항목만 골라 재사용
+	steps := []pipelineStep{
+		c.openEditorStep(c.blogURL("/manage/post/" + postID)),
+		c.dismissDraftStep(),
+		{name: stepEditorLoad, timeout: 15 * time.Second,
+			run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+				if _, err := c.waitFor(page, ActionTitle, 10*time.Second); err != nil {
+					return fmt.Errorf("글 %s 에디터를 열 수 없습니다 (삭제되었거나 권한 없음): %w", postID, err)
+				}
+				return nil
+			},
+		},
+	}
+	if upd.Title != nil {
+		steps = append(steps, c.titleStep(*upd.Title))
+	}
+	p := &publishRun{}
+	if upd.Content != nil {
+		p.content = *upd.Content
+		steps = append(steps, c.imagesStep(""), c.contentStep())
+	}
+	if upd.Tags != nil {
+		steps = append(steps, c.tagsStep(normalizeTags(upd.Tags)))
+	}
+	if upd.Category != nil {
+		steps = append(steps, c.categoryStep(*upd.Category))
+	}
+	steps = append(steps, c.publishLayerStep())
+	if upd.Publish != nil {
+		steps = append(steps, c.optionsStep(opts))
+	}
+	steps = append(steps, c.publishStep(opts, nil), pipelineStep{name: stepConfirm, timeout: publishConfirmTimeout + 10*time.Second,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			result := &PostResult{PostID: postID, PublishedAt: time.Now()}
+			select {
+			case resp := <-p.responses:
+				if resp.PostID != postID {
+					return fmt.Errorf("%w: 수정 응답의 글 ID %s ≠ %s", ErrPublishNotConfirmed, resp.PostID, postID)
+				}
+				result.URL = resp.URL
+			case <-time.After(publishConfirmTimeout):
+				return fmt.Errorf("%w: 글 %s 저장 응답 없음", ErrPublishNotConfirmed, postID)
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if result.URL == "" {
+				result.URL = c.blogURL("/" + postID)
+			}
+			p.result = result
+			return nil
+		},
+	})
+
+	defer p.close()
+	if err := c.runPipeline(ctx, p, steps); err != nil {
+		return nil, err
+	}
+
+	logging.From(ctx).Debug("✅ 글 수정 완료", "post_id", postID, "url", p.result.URL)
+	return p.result, nil
+}
+
+// DeletePost 관리 목록에서 글 삭제
+func (c *Client) DeletePost(ctx context.Context, postID string) error {
+	if err := validatePostID(postID); err != nil {
+		return err
+	}
+	if !c.loggedIn.Load() {
+		if err := c.Login(ctx); err != nil {
+			return err
+		}
+	}
+
+	page, closePage, err := c.openManagePage(ctx, PostFilter{})
+	if err != nil {
+		return err
+	}
+	defer closePage()
+
+	// 삭제 확인 다이얼로그는 수락
+	log := pageLog(page).With("post_id", postID)
+	go page.EachEvent(func(e *proto.PageJavascriptDialogOpening) {
+		log.Info("📢 다이얼로그 감지", "message", e.Message)
+		_ = proto.PageHandleJavaScriptDialog{Accept: true}.Call(page)
+	})()
+
+	clicked, err := page.Eval(`(id) => {
+		const link = document.querySelector('a[href*="/manage/post/' + id + '"], a[href*="/manage/newpost/' + id + '"]');
+		if (!link) return { ok: false, error: '목록에서 글을 찾을 수 없음' };
+		const row = link.closest('li, tr, .post_cont, [class*="post"]') || link.parentElement;
+		row.dispatchEvent(new MouseEvent('mouseover', { bubbles: true }));
+		const buttons = row.querySelectorAll('button, a');
+		for (const b of buttons) {
+			if ((b.textContent || '').trim() === '삭제') {
+				b.click();
+				return { ok: true };
+			}
+		}
+		return { ok: false, error: '삭제 버튼을 찾을 수 없음' };
+	}`, postID)
+	if err != nil {
+		return fmt.Errorf("삭제 버튼 클릭 실패: %w", err)
+	}
+	if m := clicked.Value.Map(); !m["ok"].Bool() {
+		return fmt.Errorf("글 %s 삭제 실패: %s", postID, m["error"].String())
+	}
+	if err := pause(ctx, 1*time.Second); err != nil {
+		return err
+	}
+
+	// 사이트 내 확인 레이어 (confirm 대신 쓰는 경우)
+	_, _ = page.Eval(`() => {
+		const buttons = document.querySelectorAll('.layer_post button, [class*="layer"] button, [role="dialog"] button');
+		for (const b of buttons) {
+			const text = (b.textContent || '').trim();
+			if (text === '확인' || text === '삭제') {
+				b.click();
+				return true;
+			}
+		}
+		return false;
+	}`)
+	if err := pause(ctx, 3*time.Second); err != nil {
+		return err
+	}
+
+	// 목록 새로고침 후 삭제 확인
+	if err := page.Reload(); err == nil {
+		_ = page.WaitLoad()
+		if err := pause(ctx, 2*time.Second); err != nil {
+			return err
+		}
+		remaining, err := page.Eval(`(id) => !!document.querySelector('a[href*="/manage/post/' + id + '"]')`, postID)
+		if err == nil && remaining.Value.Bool() {
+			return fmt.Errorf("글 %s 삭제 후에도 목록에 남아 있음", postID)
+		}
+	}
+
+	log.Debug("🗑️ 글 삭제 완료")
+	return nil
+}
+
+// openManagePage 글 관리 목록 페이지 열기 (closePage로 탭 닫기)
+func (c *Client) openManagePage(ctx context.Context, filter PostFilter) (*rod.Page, func(), error) {
+	query := url.Values{}
+	query.Set("category", "-3") // 전체 카테고리
+	query.Set("visibility", "all")
+	if filter.Page > 1 {
+		query.Set("page", strconv.Itoa(filter.Page))
+	}
+	if filter.Search != "" {
+		query.Set("searchType", "title")
+		query.Set("searchKeyword", filter.Search)
+	}
+
+	manageURL := c.blogURL("/manage/posts/?" + query.Encode())
+	page, closePage, err := c.openPage(ctx, manageURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("관리 페이지 열기 실패: %w", err)
+	}
+	if err := page.WaitLoad(); err != nil {
+		closePage()
+		return nil, nil, fmt.Errorf("페이지 로딩 실패: %w", err)
+	}
+	if err := pause(ctx, 2*time.Second); err != nil {
+		closePage()
+		return nil, nil, err
+	}
+	if err := c.checkSession(page); err != nil {
+		closePage()
+		return nil, nil, err
+	}
+	return page, closePage, nil
+}
+
+// validatePostID 숫자 글 ID 확인
+func validatePostID(postID string) error {
+	if _, err := strconv.Atoi(postID); err != nil {
+		return fmt.Errorf("잘못된 글 ID: %q", postID)
+	}
+	return nil
+}
@@ -0,0 +1,407 @@
+package tistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// 발행 파이프라인 (에디터 열기 → 임시저장 복구 안내 닫기 → 제목 → 이미지 첨부 → 본문 → 태그 → 카테고리 → 발행 옵션 → 발행 확인)
+// 단계마다 제한 시간과 재시도 횟수가 있고, 끝내 실패하면 화면을 screenshotDir에 저장한다.
+
+const (
+	stepRetryDelay    = 2 * time.Second // 단계 재시도 전 대기
+	screenshotTimeout = 5 * time.Second // 실패 화면 캡처 제한 시간
+)
+
+// Post 발행할 글
+type Post struct {
+	Title     string
+	Content   string // 본문 HTML
+	Category  string // 블로그 카테고리 이름 (빈 값 = 기본 카테고리)
+	Tags      []string
+	Thumbnail string // 대표이미지 파일 경로 (빈 값 = 본문 첫 이미지)
+	Options   PublishOptions
+
+	// BeforePublish 발행 버튼을 누르기 직전 호출 (baseline: 같은 제목 기존 글 중 가장 큰 글 ID, -1 = 조회 실패)
+	// 에러를 반환하면 발행하지 않는다. 임시저장에는 호출하지 않음.
+	BeforePublish func(baseline int) error
+}
+
+// pipelineStep 파이프라인 단계 1개
+type pipelineStep struct {
+	name    string        // 단계 이름 (로그/스크린샷 파일 이름, 대부분 step 메트릭 라벨과 같음)
+	timeout time.Duration // 시도 1회 제한 시간
+	retries int           // 실패 시 다시 시도할 횟수 (다시 해도 결과가 같은 단계만)
+	run     func(ctx context.Context, page *rod.Page, p *publishRun) error
+}
+
+// publishRun 파이프라인 1회 실행 상태 (단계끼리 주고받는 값)
+type publishRun struct {
+	ctx       context.Context // 파이프라인 전체 ctx (에디터 탭 수명)
+	page      *rod.Page       // 에디터 탭 (editor_load 단계에서 열림)
+	closePage func()
+	content   string // 이미지 주소를 바꾼 본문
+	cover     Cover
+	baseline  int // 발행 전 같은 제목 글 중 가장 큰 글 ID (관리 목록 확인은 이보다 새 글만 인정, -1 = 관리 목록 확인 안 함)
+	responses <-chan publishResponse
+	stopWatch func()
+	result    *PostResult
+}
+
+// close 발행 응답 감시를 멈추고 에디터 탭 닫기
+func (p *publishRun) close() {
+	if p.stopWatch != nil {
+		p.stopWatch()
+		p.stopWatch = nil
+	}
+	if p.closePage != nil {
+		p.closePage()
+		p.closePage = nil
+	}
+}
+
+// runPipeline 단계를 순서대로 실행 (첫 실패에서 중단, 탭은 호출한 쪽이 p.close로 닫음)
+func (c *Client) runPipeline(ctx context.Context, p *publishRun, steps []pipelineStep) error {
+	p.ctx = ctx
+	for _, s := range steps {
+		if err := c.runStep(ctx, p, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runStep 단계 1개 실행 (시도마다 제한 시간, 세션 만료/취소가 아니면 retries만큼 재시도, 최종 실패 시 스크린샷)
+func (c *Client) runStep(ctx context.Context, p *publishRun, s pipelineStep) error {
+	var err error
+	for attempt := 0; attempt <= s.retries; attempt++ {
+		if attempt > 0 {
+			logging.From(ctx).Warn("🔁 발행 단계 재시도", "step", s.name, "attempt", attempt+1, "err", err)
+			if err := pause(ctx, stepRetryDelay); err != nil {
+				return err
+			}
+		}
+
+		stepCtx, cancel := context.WithTimeout(ctx, s.timeout)
+		var page *rod.Page
+		if p.page != nil {
+			page = p.page.Context(stepCtx)
+		}
+		err = s.run(stepCtx, page, p)
+		timedOut := errors.Is(stepCtx.Err(), context.DeadlineExceeded)
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if timedOut {
+			err = fmt.Errorf("시간 초과 (%s): %w", s.timeout, err)
+		}
+		if errors.Is(err, ErrSessionExpired) {
+			break
+		}
+	}
+
+	c.screenshot(p, s.name)
+	return fmt.Errorf("%s 단계: %w", s.name, err)
+}
+
+// screenshot 실패한 단계의 화면 저장 ({dir}/{시각}_{블로그}_{단계}.png, 실패해도 경고만)
+func (c *Client) screenshot(p *publishRun, step string) {
+	if c.screenshotDir == "" || p.page == nil || p.ctx.Err() != nil {
+		return
+	}
+	log := pageLog(p.page)
+	data, err := p.page.Timeout(screenshotTimeout).Screenshot(false, nil)
+	if err != nil {
+		log.Warn("⚠️ 실패 화면 캡처 실패", "step", step, "err", err)
+		return
+	}
+	if err := os.MkdirAll(c.screenshotDir, 0755); err != nil {
+		log.Warn("⚠️ 스크린샷 디렉토리 생성 실패", "err", err)
+		return
+	}
+	name := fmt.Sprintf("%s_%s_%s.png", time.Now().Format("20060102-150405"), c.blogName, step)
+	path := filepath.Join(c.screenshotDir, name)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		log.Warn("⚠️ 스크린샷 저장 실패", "err", err)
+		return
+	}
+	log.Info("📸 실패 화면 저장", "step", step, "path", path)
+}
+
+// SetScreenshotDir 단계 실패 시 화면을 저장할 디렉토리 (빈 값 = 저장 안 함)
+func (c *Client) SetScreenshotDir(dir string) {
+	c.screenshotDir = dir
+}
+
+// writeSteps 새 글 발행 단계 (임시저장이면 카테고리 다음에 저장하고 끝)
+func (c *Client) writeSteps(post *Post) []pipelineStep {
+	// 임시저장은 발행 레이어를 열지 않아 대표이미지를 정할 수 없으므로 썸네일을 본문 맨 앞에 첨부
+	// (나중에 발행하면 본문 첫 이미지인 썸네일이 대표이미지가 됨)
+	prepend := ""
+	if post.Options.CoverInBody || post.Options.Draft {
+		prepend = post.Thumbnail
+	}
+	steps := []pipelineStep{
+		c.openEditorStep(c.blogURL("/manage/newpost")),
+		c.dismissDraftStep(),
+		c.titleStep(post.Title),
+		c.imagesStep(prepend),
+		c.contentStep(),
+	}
+	if tags := normalizeTags(post.Tags); len(tags) > 0 {
+		steps = append(steps, c.tagsStep(tags))
+	}
+	if post.Category != "" {
+		steps = append(steps, c.categoryStep(post.Category))
+	}
+
+	// 임시저장: 발행 레이어를 열지 않고 에디터에서 저장
+	if post.Options.Draft {
+		return append(steps, c.draftStep())
+	}
+	return append(steps,
+		c.baselineStep(post.Title),
+		c.publishLayerStep(),
+		c.coverStep(post.Thumbnail),
+		c.optionsStep(post.Options),
+		c.publishStep(post.Options, post.BeforePublish),
+		c.confirmStep(post.Title, post.Options),
+	)
+}
+
+// openEditorStep 에디터 탭 열기 + 로딩 + 세션 확인 (재시도하면 탭을 새로 엶)
+func (c *Client) openEditorStep(editorURL string) pipelineStep {
+	return pipelineStep{name: stepEditorLoad, timeout: 60 * time.Second, retries: 1,
+		run: func(ctx context.Context, _ *rod.Page, p *publishRun) error {
+			defer observeStep(stepEditorLoad, time.Now())
+			p.close()
+			page, closePage, err := c.openPage(p.ctx, editorURL)
+			if err != nil {
+				return fmt.Errorf("에디터 페이지 열기 실패: %w", err)
+			}
+			p.page, p.closePage = page, closePage
+
+			// 브라우저 다이얼로그(임시저장 복구 confirm 등)는 "취소" - 페이지 로드 전에 설정
+			log := pageLog(page)
+			go page.EachEvent(func(e *proto.PageJavascriptDialogOpening) {
+				log.Info("📢 다이얼로그 감지", "message", e.Message)
+				_ = proto.PageHandleJavaScriptDialog{Accept: false}.Call(page)
+			})()
+
+			if err := page.Context(ctx).WaitLoad(); err != nil {
+				return fmt.Errorf("페이지 로딩 실패: %w", err)
+			}
+			return c.checkSession(page)
+		},
+	}
+}
+
+// dismissDraftStep 에디터가 자리 잡을 때까지 기다리고 화면 안의 임시저장 복구 안내 닫기
+func (c *Client) dismissDraftStep() pipelineStep {
+	return pipelineStep{name: stepDraftDialog, timeout: 15 * time.Second,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			defer observeStep(stepDraftDialog, time.Now())
+			if err := pause(ctx, 3*time.Second); err != nil {
+				return err
+			}
+			if err := c.clickAction(page, ActionDraftRestoreCancel, ""); err == nil {
+				pageLog(page).Info("📢 임시저장 복구 안내 닫음")
+				if err := pause(ctx, 1*time.Second); err != nil {
+					return err
+				}
+			}
+			if err := c.checkSession(page); err != nil {
+				return err
+			}
+			pageLog(page).Debug("✅ 페이지 로딩 완료")
+			return nil
+		},
+	}
+}
+
+// titleStep 제목 입력
+func (c *Client) titleStep(title string) pipelineStep {
+	return pipelineStep{name: stepTitle, timeout: 20 * time.Second, retries: 2,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			return c.setTitle(page, title)
+		},
+	}
+}
+
+// imagesStep 외부/로컬 이미지를 티스토리에 올리고 본문 주소 교체, prepend가 있으면 본문 맨 앞에 첨부
+// 본문 입력 전에 올려야 함 (첨부가 에디터에 넣은 이미지는 본문 입력으로 교체됨). 다시 하면 중복 업로드되므로 재시도 없음.
+func (c *Client) imagesStep(prepend string) pipelineStep {
+	return pipelineStep{name: stepImages, timeout: (maxImages + 2) * imageUploadTimeout,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			content, err := c.attachImages(ctx, page, p.content)
+			if err != nil {
+				return err
+			}
+			if prepend != "" {
+				if content, err = c.prependImage(ctx, page, content, prepend); err != nil {
+					return err
+				}
+			}
+			p.content = content
+			return nil
+		},
+	}
+}
+
+// contentStep 본문 입력 (대표이미지를 따로 정하지 않으면 본문 첫 이미지가 대표이미지)
+func (c *Client) contentStep() pipelineStep {
+	return pipelineStep{name: stepContent, timeout: 30 * time.Second, retries: 2,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			if err := c.setContent(page, p.content); err != nil {
+				return err
+			}
+			p.cover = bodyCover(p.content)
+			pageLog(page).Debug("📝 본문 입력 완료")
+			return nil
+		},
+	}
+}
+
+// tagsStep 태그 입력 (재시도해도 중복되지 않게 입력된 태그를 먼저 지움)
+func (c *Client) tagsStep(tags []string) pipelineStep {
+	return pipelineStep{name: stepTags, timeout: 60 * time.Second, retries: 1,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			if n := c.clearTags(page); n > 0 {
+				pageLog(page).Debug("🏷️ 기존 태그 삭제", "count", n)
+			}
+			pageLog(page).Debug("🏷️ 태그 입력", "tags", tags, "max", maxTags)
+			return c.addTags(page, tags)
+		},
+	}
+}
+
+// categoryStep 카테고리 선택
+func (c *Client) categoryStep(name string) pipelineStep {
+	return pipelineStep{name: stepCategory, timeout: 20 * time.Second, retries: 1,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			return c.selectCategory(page, name)
+		},
+	}
+}
+
+// draftStep 임시저장 (발행 레이어를 열지 않음)
+func (c *Client) draftStep() pipelineStep {
+	return pipelineStep{name: stepDraft, timeout: 20 * time.Second,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			if err := c.saveDraft(page); err != nil {
+				return err
+			}
+			p.result = &PostResult{Draft: true}
+			return nil
+		},
+	}
+}
+
+// baselineStep 발행 전에 같은 제목의 기존 글 ID 기록 (발행 응답이 없을 때 관리 목록에서 기존 글을 새 글로 오인하지 않도록)
+// 조회에 실패하면 경고만 남기고, 발행 확인은 저장 요청 응답으로만 한다.
+func (c *Client) baselineStep(title string) pipelineStep {
+	return pipelineStep{name: stepBaseline, timeout: 45 * time.Second,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			defer observeStep(stepBaseline, time.Now())
+			id, err := c.latestPostID(ctx, title)
+			if err != nil {
+				if p.ctx.Err() != nil {
+					return p.ctx.Err()
+				}
+				pageLog(page).Warn("⚠️ 기존 글 조회 실패, 발행 응답으로만 확인", "err", err)
+				p.baseline = -1
+				return nil
+			}
+			p.baseline = id
+			return nil
+		},
+	}
+}
+
+// publishLayerStep 완료 버튼 → 발행 레이어 (다시 누르면 레이어가 닫힐 수 있어 재시도 없음)
+func (c *Client) publishLayerStep() pipelineStep {
+	return pipelineStep{name: stepPublishLayer, timeout: 20 * time.Second,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			return c.openPublishLayer(page)
+		},
+	}
+}
+
+// coverStep 발행 레이어 대표이미지 설정 (실패하면 경고만, 티스토리가 본문 첫 이미지를 사용)
+// 업로드했지만 미리보기로 확인하지 못한 대표이미지는 CoverUnconfirmed로 남긴다.
+func (c *Client) coverStep(thumbnailPath string) pipelineStep {
+	return pipelineStep{name: stepThumbnail, timeout: 30 * time.Second,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			if thumbnailPath == "" {
+				return nil
+			}
+			url, confirmed, err := c.setRepresentativeImage(page, thumbnailPath)
+			if err != nil {
+				if p.ctx.Err() != nil {
+					return p.ctx.Err()
+				}
+				pageLog(page).Warn("⚠️ 대표이미지 설정 실패, 본문 첫 이미지 사용", "err", err)
+				return nil
+			}
+			if !confirmed {
+				p.cover = Cover{Source: CoverUnconfirmed, Image: thumbnailPath}
+				return nil
+			}
+			p.cover = Cover{Source: CoverThumbnail, Image: thumbnailPath, URL: url}
+			return nil
+		},
+	}
+}
+
+// optionsStep 공개 범위/예약 설정 (실패 시 의도와 다르게 발행되지 않도록 중단)
+func (c *Client) optionsStep(opts PublishOptions) pipelineStep {
+	return pipelineStep{name: stepOptions, timeout: 30 * time.Second, retries: 1,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			return c.applyPublishOptions(page, opts)
+		},
+	}
+}
+
+// publishStep 저장 요청 응답 감시 시작 후 최종 발행 버튼 클릭 (중복 발행 방지를 위해 재시도 없음)
+// before가 있으면 클릭 직전에 baseline과 함께 호출하고, 실패하면 클릭하지 않는다.
+func (c *Client) publishStep(opts PublishOptions, before func(baseline int) error) pipelineStep {
+	return pipelineStep{name: stepPublish, timeout: 15 * time.Second,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			if before != nil {
+				if err := before(p.baseline); err != nil {
+					return err
+				}
+			}
+			// 감시는 단계가 끝나도 발행 확인까지 이어져야 하므로 파이프라인 탭에서 시작
+			p.responses, p.stopWatch = c.watchPublish(p.page)
+			return c.clickPublish(page, opts)
+		},
+	}
+}
+
+// confirmStep 발행 요청 응답(우선) 또는 관리 목록으로 발행 확인
+func (c *Client) confirmStep(title string, opts PublishOptions) pipelineStep {
+	return pipelineStep{name: stepConfirm, timeout: publishConfirmTimeout + time.Minute,
+		run: func(ctx context.Context, page *rod.Page, p *publishRun) error {
+			result, err := c.confirmPublish(ctx, p.responses, title, opts, p.baseline)
+			if err != nil {
+				return err
+			}
+			p.result = result
+			return nil
+		},
+	}
+}
@@ -0,0 +1,316 @@
+package tistory
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// 프로필 액션 이름
+const (
+	ActionLoginKakao         = "login_kakao"
+	ActionLoginEmail         = "login_email"
+	ActionLoginPassword      = "login_password"
+	ActionLoginSubmit        = "login_submit"
+	ActionTitle              = "title"
+	ActionEditorIframe       = "editor_iframe"
+	ActionEditorBody         = "editor_body"
+	ActionTagInput           = "tag_input"
+	ActionTagDelete          = "tag_delete"
+	ActionCategorySelect     = "category_select"
+	ActionCategoryDropdown   = "category_dropdown"
+	ActionCategoryOption     = "category_option"
+	ActionImageButton        = "image_button"
+	ActionFileInput          = "file_input"
+	ActionDraftButton        = "draft_button"
+	ActionDraftRestoreCancel = "draft_restore_cancel"
+	ActionCompleteButton     = "complete_button"
+	ActionThumbnailInput     = "thumbnail_input"
+	ActionThumbnailBox       = "thumbnail_box"
+	ActionThumbnailPreview   = "thumbnail_preview"
+	ActionVisibilityOption   = "visibility_option"
+	ActionPasswordInput      = "password_input"
+	ActionReserveButton      = "reserve_button"
+	ActionReserveDate        = "reserve_date"
+	ActionReserveHour        = "reserve_hour"
+	ActionReserveMinute      = "reserve_minute"
+	ActionPublishButton      = "publish_button"
+)
+
+// ErrSelectorNotFound 프로필의 모든 전략으로 요소를 찾지 못함
+var ErrSelectorNotFound = errors.New("셀렉터와 일치하는 요소 없음")
+
+//go:embed profiles/default.yaml
+var defaultProfileYAML []byte
+
+// Strategy 요소 검색 전략 1개
+type Strategy struct {
+	CSS      string `yaml:"css,omitempty" json:"css,omitempty"`           // CSS 선택자 (text/contains 가 있으면 후보 목록)
+	Text     string `yaml:"text,omitempty" json:"text,omitempty"`         // 요소 텍스트 정확히 일치
+	Contains string `yaml:"contains,omitempty" json:"contains,omitempty"` // 요소 텍스트 포함
+	Exclude  string `yaml:"exclude,omitempty" json:"exclude,omitempty"`   // 요소 텍스트에 포함되면 제외
+	Closest  string `yaml:"closest,omitempty" json:"closest,omitempty"`   // 찾은 요소의 조상으로 이동
+}
+
+// String 로그용 표기
+func (s Strategy) String() string {
+	var parts []string
+	if s.CSS != "" {
+		parts = append(parts, s.CSS)
+	}
+	if s.Text != "" {
+		parts = append(parts, fmt.Sprintf("text=%q", s.Text))
+	}
+	if s.Contains != "" {
+		parts = append(parts, fmt.Sprintf("contains=%q", s.Contains))
+	}
+	if s.Exclude != "" {
+		parts = append(parts, fmt.Sprintf("exclude=%q", s.Exclude))
+	}
+	if s.Closest != "" {
+		parts = append(parts, "closest="+s.Closest)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Profile 에디터 셀렉터 프로필 (액션별 순서 있는 대체 전략)
+type Profile struct {
+	Version string                `yaml:"version"`
+	Source  string                `yaml:"-"` // 로드한 파일 (빈 값 = 내장 기본값)
+	Actions map[string][]Strategy `yaml:"actions"`
+}
+
+// DefaultProfile 바이너리에 내장된 기본 프로필
+func DefaultProfile() *Profile {
+	var p Profile
+	if err := yaml.Unmarshal(defaultProfileYAML, &p); err != nil {
+		panic(fmt.Sprintf("내장 셀렉터 프로필 파싱 실패: %v", err))
+	}
+	return &p
+}
+
+// LoadProfile 프로필 파일 로드 (없는 액션은 기본 프로필 사용)
+func LoadProfile(path string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("셀렉터 프로필 읽기 실패: %w", err)
+	}
+
+	var override Profile
+	if err := yaml.Unmarshal(data, &override); err != nil {
+		return nil, fmt.Errorf("셀렉터 프로필 파싱 실패 (%s): %w", path, err)
+	}
+
+	p := DefaultProfile()
+	for action, strategies := range override.Actions {
+		// 기본 프로필에 없는 액션은 오타이므로 (코드에서 쓰지 않아 조용히 무시됨) 로드 시 거부
+		if _, ok := p.Actions[action]; !ok {
+			return nil, fmt.Errorf("셀렉터 프로필 %s: 알 수 없는 액션 '%s'", path, action)
+		}
+		if len(strategies) == 0 {
+			return nil, fmt.Errorf("셀렉터 프로필 %s: 액션 '%s'에 전략이 없습니다", path, action)
+		}
+		p.Actions[action] = strategies
+	}
+	if override.Version != "" {
+		p.Version = override.Version
+	}
+	p.Source = path
+	return p, nil
+}
+
+// Describe 로그용 요약
+func (p *Profile) Describe() string {
+	source := "내장"
+	if p.Source != "" {
+		source = p.Source
+	}
+	return fmt.Sprintf("v%s (%s)", p.Version, source)
+}
+
+// strategies 액션의 전략 목록
+func (p *Profile) strategies(action string) []Strategy {
+	return p.Actions[action]
+}
+
+// resolveJS 전략을 순서대로 시도해 첫 요소 반환 (indexOnly면 일치한 전략 번호, 없으면 -1)
+const resolveJS = `(strategies, value, indexOnly) => {
+	const sub = (t) => t ? t.split('{value}').join(value) : t;
+	for (let i = 0; i < strategies.length; i++) {
+		const s = strategies[i];
+		const text = sub(s.text), contains = sub(s.contains);
+		let found = null;
+		try {
+			if (!text && !contains) {
+				found = document.querySelector(s.css);
+			} else {
+				for (const el of document.querySelectorAll(s.css || 'button')) {
+					const t = (el.textContent || '').trim();
+					if (text && t !== text) continue;
+					if (contains && !t.includes(contains)) continue;
+					if (s.exclude && t.includes(s.exclude)) continue;
+					found = el;
+					break;
+				}
+			}
+		} catch (e) {
+			continue; // 잘못된 선택자는 건너뜀
+		}
+		if (found && s.closest) found = found.closest(s.closest) || found;
+		if (found) return indexOnly ? i : found;
+	}
+	return indexOnly ? -1 : null;
+}`
+
+// 요소 대상 JS (this = 요소)
+const (
+	clickJS    = `function () { this.click(); }`
+	setValueJS = `function (value) {
+		const proto = this.tagName === 'SELECT' ? HTMLSelectElement.prototype
+			: this.tagName === 'TEXTAREA' ? HTMLTextAreaElement.prototype
+			: HTMLInputElement.prototype;
+		Object.getOwnPropertyDescriptor(proto, 'value').set.call(this, value);
+		this.dispatchEvent(new Event('input', { bubbles: true }));
+		this.dispatchEvent(new Event('change', { bubbles: true }));
+	}`
+)
+
+// find 프로필 전략 순서대로 요소 검색 (대기 없음)
+func (c *Client) find(page *rod.Page, action, value string) (*rod.Element, error) {
+	el, err := page.Sleeper(rod.NotFoundSleeper).ElementByJS(rod.Eval(resolveJS, c.profile.strategies(action), value, false))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrSelectorNotFound, action)
+	}
+	return el, nil
+}
+
+// waitFor 요소가 나타날 때까지 대기 후 검색
+func (c *Client) waitFor(page *rod.Page, action string, timeout time.Duration) (*rod.Element, error) {
+	el, err := page.Timeout(timeout).ElementByJS(rod.Eval(resolveJS, c.profile.strategies(action), "", false))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s (%s 대기)", ErrSelectorNotFound, action, timeout)
+	}
+	return el.CancelTimeout(), nil
+}
+
+// clickAction 액션 요소를 찾아 클릭
+func (c *Client) clickAction(page *rod.Page, action, value string) error {
+	el, err := c.find(page, action, value)
+	if err != nil {
+		return err
+	}
+	if _, err := el.Eval(clickJS); err != nil {
+		return fmt.Errorf("%s 클릭 실패: %w", action, err)
+	}
+	return nil
+}
+
+// setAction 액션 입력 요소의 값 설정 (input/change 이벤트 발생)
+func (c *Client) setAction(page *rod.Page, action, value string) error {
+	el, err := c.find(page, action, "")
+	if err != nil {
+		return err
+	}
+	if _, err := el.Eval(setValueJS, value); err != nil {
+		return fmt.Errorf("%s 입력 실패: %w", action, err)
+	}
+	return nil
+}
+
+// ProbeResult doctor editor 결과 1건
+type ProbeResult struct {
+	Action   string
+	Matched  int    // 일치한 전략 번호 (-1 = 없음)
+	Strategy string // 일치한 전략 표기
+	Total    int    // 전략 개수
+}
+
+// probe 액션별로 어떤 전략이 일치하는지 확인
+func (c *Client) probe(page *rod.Page, action, value string) ProbeResult {
+	strategies := c.profile.strategies(action)
+	result := ProbeResult{Action: action, Matched: -1, Total: len(strategies)}
+
+	res, err := page.Eval(resolveJS, strategies, value, true)
+	if err != nil {
+		return result
+	}
+	if i := res.Value.Int(); i >= 0 && i < len(strategies) {
+		result.Matched = i
+		result.Strategy = strategies[i].String()
+	}
+	return result
+}
+
+// 진단 대상 액션 (화면별, 대체 경로 전용인 editor_body/thumbnail_box 등은 제외)
+var (
+	editorProbeActions = []string{
+		ActionTitle, ActionEditorIframe, ActionTagInput,
+		ActionCategoryDropdown, ActionImageButton, ActionDraftButton, ActionCompleteButton,
+	}
+	publishLayerProbeActions = []string{
+		ActionThumbnailInput, ActionVisibilityOption, ActionReserveButton, ActionPublishButton,
+	}
+)
+
+// DiagnoseEditor 새 글 에디터와 발행 레이어를 열어 각 액션의 셀렉터 확인 (발행하지 않음)
+func (c *Client) DiagnoseEditor(ctx context.Context) ([]ProbeResult, error) {
+	if !c.loggedIn.Load() {
+		if err := c.Login(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	editorURL := c.blogURL("/manage/newpost")
+	page, closePage, err := c.openPage(ctx, editorURL)
+	if err != nil {
+		return nil, fmt.Errorf("에디터 페이지 열기 실패: %w", err)
+	}
+	defer closePage()
+
+	go page.EachEvent(func(e *proto.PageJavascriptDialogOpening) {
+		_ = proto.PageHandleJavaScriptDialog{Accept: false}.Call(page)
+	})()
+
+	if err := page.WaitLoad(); err != nil {
+		return nil, fmt.Errorf("페이지 로딩 실패: %w", err)
+	}
+	if err := pause(ctx, 3*time.Second); err != nil {
+		return nil, err
+	}
+	if err := c.checkSession(page); err != nil {
+		return nil, err
+	}
+
+	var results []ProbeResult
+	for _, action := range editorProbeActions {
+		results = append(results, c.probe(page, action, ""))
+	}
+
+	// 발행 레이어는 완료 버튼을 눌러야 나타남
+	if err := c.clickAction(page, ActionCompleteButton, ""); err == nil {
+		if err := pause(ctx, 3*time.Second); err != nil {
+			return nil, err
+		}
+		for _, action := range publishLayerProbeActions {
+			value := ""
+			if action == ActionVisibilityOption {
+				value = VisibilityPublic.String()
+			}
+			results = append(results, c.probe(page, action, value))
+		}
+	} else {
+		for _, action := range publishLayerProbeActions {
+			results = append(results, ProbeResult{Action: action, Matched: -1, Total: len(c.profile.strategies(action))})
+		}
+	}
+
+	return results, nil
+}
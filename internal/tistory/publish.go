@@ -0,0 +1,159 @@
+package tistory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod"
+)
+
+// Visibility 공개 범위
+type Visibility int
+
+const (
+	VisibilityPublic    Visibility = iota // 공개
+	VisibilityProtected                   // 보호 (비밀번호)
+	VisibilityPrivate                     // 비공개
+)
+
+// String 발행 레이어에 표시되는 라벨
+func (v Visibility) String() string {
+	switch v {
+	case VisibilityProtected:
+		return "보호"
+	case VisibilityPrivate:
+		return "비공개"
+	default:
+		return "공개"
+	}
+}
+
+// ParseVisibility 문자열을 공개 범위로 변환 (public | protected | private)
+func ParseVisibility(s string) (Visibility, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "public":
+		return VisibilityPublic, nil
+	case "protected":
+		return VisibilityProtected, nil
+	case "private":
+		return VisibilityPrivate, nil
+	}
+	return VisibilityPublic, fmt.Errorf("알 수 없는 공개 범위: %s (public | protected | private)", s)
+}
+
+// PublishOptions 발행 옵션
+type PublishOptions struct {
+	Draft      bool       // 임시저장만 하고 발행하지 않음
+	Visibility Visibility // 공개 범위
+	Password   string     // 보호글 비밀번호 (VisibilityProtected 전용)
+	ReserveAt  time.Time  // 예약 발행 시각 (zero = 즉시 발행)
+
+	// CoverInBody 대표이미지(썸네일)를 본문 맨 앞에도 첨부 (false = 발행 레이어에서만 설정)
+	CoverInBody bool
+}
+
+// Validate 옵션 유효성 검사
+func (o PublishOptions) Validate() error {
+	if o.Visibility == VisibilityProtected && o.Password == "" {
+		return fmt.Errorf("보호글은 비밀번호가 필요합니다")
+	}
+	if !o.ReserveAt.IsZero() && o.ReserveAt.Before(time.Now()) {
+		return fmt.Errorf("예약 시각이 과거입니다: %s", o.ReserveAt.Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+// Describe 로그용 요약
+func (o PublishOptions) Describe() string {
+	if o.Draft {
+		return "임시저장"
+	}
+	desc := o.Visibility.String()
+	if !o.ReserveAt.IsZero() {
+		desc += fmt.Sprintf(" 예약(%s)", o.ReserveAt.Format("01/02 15:04"))
+	}
+	return desc
+}
+
+// saveDraft 에디터의 임시저장 버튼 클릭
+func (c *Client) saveDraft(page *rod.Page) error {
+	defer observeStep(stepDraft, time.Now())
+	if err := c.clickAction(page, ActionDraftButton, ""); err != nil {
+		return fmt.Errorf("임시저장 버튼을 찾을 수 없음: %w", err)
+	}
+
+	pageLog(page).Info("💾 임시저장 버튼 클릭")
+	return pause(page.GetContext(), 3*time.Second)
+}
+
+// applyPublishOptions 발행 레이어에서 공개 범위/비밀번호/예약 시각 설정
+// 공개가 아닌 설정을 적용하지 못하면 공개 발행되지 않도록 에러 반환
+func (c *Client) applyPublishOptions(page *rod.Page, opts PublishOptions) error {
+	defer observeStep(stepOptions, time.Now())
+	log := pageLog(page)
+	log.Debug("📤 공개 범위 선택", "visibility", opts.Visibility.String())
+
+	if err := c.clickAction(page, ActionVisibilityOption, opts.Visibility.String()); err != nil && opts.Visibility != VisibilityPublic {
+		return fmt.Errorf("공개 범위 '%s' 옵션을 찾을 수 없음: %w", opts.Visibility, err)
+	}
+	if err := pause(page.GetContext(), 500*time.Millisecond); err != nil {
+		return err
+	}
+
+	if opts.Visibility == VisibilityProtected {
+		if err := c.setAction(page, ActionPasswordInput, opts.Password); err != nil {
+			return fmt.Errorf("보호글 비밀번호 입력란을 찾을 수 없음: %w", err)
+		}
+		log.Debug("🔒 보호글 비밀번호 입력")
+	}
+
+	if !opts.ReserveAt.IsZero() {
+		if err := c.applyReserve(page, opts.ReserveAt); err != nil {
+			return err
+		}
+	}
+
+	return pause(page.GetContext(), 1*time.Second)
+}
+
+// applyReserve 예약 발행 시각 설정
+func (c *Client) applyReserve(page *rod.Page, at time.Time) error {
+	pageLog(page).Info("⏰ 예약 발행", "reserve_at", at.Format("2006-01-02 15:04"))
+
+	if err := c.clickAction(page, ActionReserveButton, ""); err != nil {
+		return fmt.Errorf("예약 발행 설정 실패: %w", err)
+	}
+	if err := pause(page.GetContext(), 500*time.Millisecond); err != nil {
+		return err
+	}
+
+	steps := []struct {
+		action, value string
+	}{
+		{ActionReserveDate, at.Format("2006-01-02")},
+		{ActionReserveHour, at.Format("15")},
+		{ActionReserveMinute, at.Format("04")},
+	}
+	for _, step := range steps {
+		if err := c.setAction(page, step.action, step.value); err != nil {
+			return fmt.Errorf("예약 발행 설정 실패: %w", err)
+		}
+	}
+	return nil
+}
+
+// clickPublish 발행 레이어의 최종 발행/저장 버튼 클릭
+// ("공개 발행" / "보호 발행" / "비공개 저장" / "예약 발행" 등, 임시저장 제외)
+func (c *Client) clickPublish(page *rod.Page, opts PublishOptions) error {
+	defer observeStep(stepPublish, time.Now())
+	log := pageLog(page)
+	log.Debug("📤 발행 버튼 클릭 시도...", "visibility", opts.Visibility.String())
+
+	if err := c.clickAction(page, ActionPublishButton, ""); err != nil {
+		return fmt.Errorf("%w (%v)", ErrPublishButtonNotFound, err)
+	}
+
+	log.Debug("✅ 발행 버튼 클릭 완료")
+	return nil
+}
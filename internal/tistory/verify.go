@@ -0,0 +1,238 @@
+package tistory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Song-wh/tistory-bot/internal/logging"
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+)
+
+// 발행 단계 실패 에러 (errors.Is로 구분)
+var (
+	ErrPublishButtonNotFound = errors.New("발행 버튼을 찾을 수 없음")
+	ErrCategoryNotFound      = errors.New("카테고리를 찾을 수 없음")
+	ErrSessionExpired        = errors.New("로그인 세션 만료")
+	ErrPublishNotConfirmed   = errors.New("발행 결과를 확인할 수 없음")
+)
+
+// 로그인 실패 에러 (errors.Is로 구분)
+var (
+	ErrLoginFailed = errors.New("로그인 실패")
+	ErrCaptcha     = errors.New("로그인 캡챠 요구 (login 명령으로 직접 로그인 필요)")
+)
+
+// publishConfirmTimeout 발행 요청 응답 대기 시간 (테스트에서 줄일 수 있게 변수)
+var publishConfirmTimeout = 20 * time.Second
+
+// publishResponse 에디터 저장 요청(/manage/post*.json) 응답에서 얻은 정보
+type publishResponse struct {
+	PostID string
+	URL    string
+}
+
+// checkSession 로그인 페이지로 리다이렉트되었으면 ErrSessionExpired
+// 다음 호출에서 다시 로그인하도록 로그인 상태를 초기화한다.
+func (c *Client) checkSession(page *rod.Page) error {
+	info, err := page.Info()
+	if err != nil {
+		return fmt.Errorf("페이지 정보 조회 실패: %w", err)
+	}
+	if strings.Contains(info.URL, "/auth/login") || strings.Contains(info.URL, "accounts.kakao.com") {
+		c.loggedIn.Store(false)
+		return fmt.Errorf("%w: %s", ErrSessionExpired, info.URL)
+	}
+	return nil
+}
+
+// captchaShown 로그인 화면에 캡챠(보안문자)가 떠 있는지
+func captchaShown(page *rod.Page, url string) bool {
+	if strings.Contains(strings.ToLower(url), "captcha") {
+		return true
+	}
+	shown, err := page.Eval(`() => !!document.querySelector('[class*="captcha"], [id*="captcha"], iframe[src*="captcha"], img[alt*="보안문자"]')`)
+	return err == nil && shown.Value.Bool()
+}
+
+// watchPublish 발행 버튼 클릭 전에 호출, 에디터 저장 요청의 응답을 채널로 전달
+func (c *Client) watchPublish(page *rod.Page) (<-chan publishResponse, func()) {
+	ch := make(chan publishResponse, 1)
+	p, cancel := page.WithCancel()
+
+	if err := (proto.NetworkEnable{}).Call(p); err != nil {
+		pageLog(page).Warn("⚠️ 네트워크 감시 실패", "err", err)
+		return ch, cancel
+	}
+
+	pending := map[proto.NetworkRequestID]bool{}
+	go p.EachEvent(
+		func(e *proto.NetworkRequestWillBeSent) {
+			if (e.Request.Method == "POST" || e.Request.Method == "PUT") && isPublishEndpoint(e.Request.URL) {
+				pending[e.RequestID] = true
+			}
+		},
+		func(e *proto.NetworkLoadingFinished) bool {
+			if !pending[e.RequestID] {
+				return false
+			}
+			body, err := proto.NetworkGetResponseBody{RequestID: e.RequestID}.Call(p)
+			if err != nil {
+				return false
+			}
+			resp, ok := parsePublishResponse(body.Body)
+			if !ok {
+				return false
+			}
+			ch <- resp
+			return true
+		},
+	)()
+
+	return ch, cancel
+}
+
+// confirmPublish 발행 요청 응답(우선) 또는 관리 목록으로 발행 확인
+// 관리 목록에서는 글 ID가 baseline보다 큰 글만 이번 발행으로 인정한다 (baseline < 0 이면 관리 목록 확인 안 함).
+func (c *Client) confirmPublish(ctx context.Context, responses <-chan publishResponse, title string, opts PublishOptions, baseline int) (*PostResult, error) {
+	defer observeStep(stepConfirm, time.Now())
+	result := &PostResult{PublishedAt: time.Now()}
+	if !opts.ReserveAt.IsZero() {
+		result.PublishedAt = opts.ReserveAt
+	}
+
+	select {
+	case resp := <-responses:
+		result.PostID = resp.PostID
+		result.URL = resp.URL
+	case <-time.After(publishConfirmTimeout):
+		if baseline < 0 {
+			return nil, fmt.Errorf("%w: 발행 응답 없음 (기존 글 조회 실패로 관리 목록 확인 불가)", ErrPublishNotConfirmed)
+		}
+		logging.From(ctx).Info("⏳ 발행 응답 없음, 관리 목록에서 확인...")
+		post, err := c.findPostByTitle(ctx, title, baseline)
+		if err != nil {
+			return nil, err
+		}
+		result.PostID = post.PostID
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if result.URL == "" {
+		result.URL = c.blogURL("/" + result.PostID)
+	}
+	logging.From(ctx).Info("🔎 발행 확인", "post_id", result.PostID, "url", result.URL)
+	return result, nil
+}
+
+// findPostByTitle 관리 목록에서 제목이 같고 글 ID가 after보다 큰 가장 최근 글 검색
+// (after 이하는 발행 전부터 있던 글이므로 이번 발행의 결과로 인정하지 않음)
+func (c *Client) findPostByTitle(ctx context.Context, title string, after int) (*PostSummary, error) {
+	matches, err := c.postsByTitle(ctx, title)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrPublishNotConfirmed, err)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: 관리 목록에 '%s' 없음", ErrPublishNotConfirmed, title)
+	}
+	if id, _ := strconv.Atoi(matches[0].PostID); id <= after {
+		return nil, fmt.Errorf("%w: 관리 목록에 '%s' 새 글 없음 (기존 글 %s)", ErrPublishNotConfirmed, title, matches[0].PostID)
+	}
+	return &matches[0], nil
+}
+
+// FindPostAfter 관리 목록에서 제목이 같고 글 ID가 after보다 큰 가장 최근 글 (없으면 nil, 중단된 발행 확인용)
+// after는 발행 직전에 기록한 baseline - 같은 제목으로 예전에 발행한 글은 인정하지 않는다.
+func (c *Client) FindPostAfter(ctx context.Context, title string, after int) (*PostSummary, error) {
+	matches, err := c.postsByTitle(ctx, title)
+	if err != nil || len(matches) == 0 {
+		return nil, err
+	}
+	if id, _ := strconv.Atoi(matches[0].PostID); id <= after {
+		return nil, nil
+	}
+	return &matches[0], nil
+}
+
+// latestPostID 제목이 같은 기존 글 중 가장 큰 글 ID (없으면 0)
+func (c *Client) latestPostID(ctx context.Context, title string) (int, error) {
+	matches, err := c.postsByTitle(ctx, title)
+	if err != nil || len(matches) == 0 {
+		return 0, err
+	}
+	id, _ := strconv.Atoi(matches[0].PostID)
+	return id, nil
+}
+
+// postsByTitle 관리 목록에서 제목이 같은 글 (글 ID 내림차순 = 최신순)
+func (c *Client) postsByTitle(ctx context.Context, title string) ([]PostSummary, error) {
+	posts, err := c.ListPosts(ctx, PostFilter{Search: title})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []PostSummary
+	for _, p := range posts {
+		if strings.TrimSpace(p.Title) == strings.TrimSpace(title) {
+			matches = append(matches, p)
+		}
+	}
+
+	// 글 ID는 발행 순으로 증가
+	sort.Slice(matches, func(i, j int) bool {
+		a, _ := strconv.Atoi(matches[i].PostID)
+		b, _ := strconv.Atoi(matches[j].PostID)
+		return a > b
+	})
+	return matches, nil
+}
+
+// isPublishEndpoint 에디터 저장 요청 URL 여부 (/manage/post.json, /manage/post/{id}.json)
+func isPublishEndpoint(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(u.Path, "/manage/post") && strings.HasSuffix(u.Path, ".json")
+}
+
+// parsePublishResponse 저장 응답 JSON에서 글 ID와 URL 추출
+func parsePublishResponse(body string) (publishResponse, bool) {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		return publishResponse{}, false
+	}
+
+	var resp publishResponse
+	if entryURL, ok := data["entryUrl"].(string); ok {
+		resp.URL = entryURL
+	}
+	for _, key := range []string{"postId", "entryId", "id"} {
+		switch v := data[key].(type) {
+		case float64:
+			resp.PostID = strconv.FormatInt(int64(v), 10)
+		case string:
+			resp.PostID = v
+		}
+		if resp.PostID != "" {
+			break
+		}
+	}
+	if resp.PostID == "" && resp.URL != "" {
+		parts := strings.Split(strings.TrimRight(resp.URL, "/"), "/")
+		resp.PostID = parts[len(parts)-1]
+	}
+
+	if _, err := strconv.Atoi(resp.PostID); err != nil {
+		return publishResponse{}, false
+	}
+	return resp, true
+}
@@ -0,0 +1,165 @@
+package worker
+
+import (
+	"sync"
+	"time"
+)
+
+// Pool 키(계정)별 워커 묶음
+// 같은 키의 작업은 전용 고루틴에서 하나씩 순서대로 실행되고,
+// 전체 동시 실행 수는 limit으로, 같은 키의 연속 실행 간격은 minGap으로 제한된다.
+type Pool struct {
+	sem    chan struct{}
+	minGap func(key string) time.Duration
+
+	mu      sync.Mutex
+	done    *sync.Cond // 워커가 작업을 끝낼 때마다 Broadcast (Hold 대기용)
+	workers map[string]*worker
+	held    map[string]bool // Hold 중인 키 (새 작업을 받지 않음)
+	idle    chan struct{}
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+// worker 키 하나의 전용 고루틴 상태
+type worker struct {
+	inbox    chan func()
+	busy     bool      // 작업이 inbox에 있거나 실행 중
+	lastDone time.Time // 마지막 작업 종료 시각
+}
+
+// NewPool 워커 풀 생성 (limit: 전체 동시 실행 수, minGap: 키별 최소 실행 간격)
+func NewPool(limit int, minGap func(key string) time.Duration) *Pool {
+	if limit < 1 {
+		limit = 1
+	}
+	if minGap == nil {
+		minGap = func(string) time.Duration { return 0 }
+	}
+	p := &Pool{
+		sem:     make(chan struct{}, limit),
+		minGap:  minGap,
+		workers: map[string]*worker{},
+		held:    map[string]bool{},
+		idle:    make(chan struct{}, 1),
+	}
+	p.done = sync.NewCond(&p.mu)
+	return p
+}
+
+// ReadyAt 키의 워커가 새 작업을 받을 수 있는 시각 (실행 중이면 ok=false)
+func (p *Pool) ReadyAt(key string) (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	w, exists := p.workers[key]
+	switch {
+	case p.held[key]:
+		return time.Time{}, false
+	case !exists:
+		return time.Time{}, true
+	case w.busy:
+		return time.Time{}, false
+	case w.lastDone.IsZero():
+		return time.Time{}, true
+	}
+	return w.lastDone.Add(p.minGap(key)), true
+}
+
+// Ready 키의 워커가 now에 새 작업을 받을 수 있는지
+func (p *Pool) Ready(key string, now time.Time) bool {
+	at, ok := p.ReadyAt(key)
+	return ok && !at.After(now)
+}
+
+// Submit 키의 워커에 작업 전달 (실행 중이거나 풀이 닫혔으면 false)
+func (p *Pool) Submit(key string, fn func()) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed || p.held[key] {
+		return false
+	}
+	w, exists := p.workers[key]
+	if !exists {
+		w = &worker{inbox: make(chan func(), 1)}
+		p.workers[key] = w
+		p.wg.Add(1)
+		go p.run(key, w)
+	}
+	if w.busy {
+		return false
+	}
+	w.busy = true
+	w.inbox <- fn
+	return true
+}
+
+// run 키 전용 고루틴: inbox의 작업을 전체 동시 실행 한도 안에서 실행
+func (p *Pool) run(key string, w *worker) {
+	defer p.wg.Done()
+	for fn := range w.inbox {
+		p.sem <- struct{}{}
+		fn()
+		<-p.sem
+
+		p.mu.Lock()
+		w.busy = false
+		w.lastDone = time.Now()
+		p.done.Broadcast()
+		p.mu.Unlock()
+
+		select {
+		case p.idle <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Hold 키의 워커가 실행 중인 작업을 끝낼 때까지 기다린 뒤, release 호출 전까지 새 작업을 받지 않게 함
+// 계정 브라우저를 교체하는 동안 그 계정의 포스팅을 막는 데 쓴다.
+func (p *Pool) Hold(key string) (release func()) {
+	p.mu.Lock()
+	p.held[key] = true
+	for {
+		w, exists := p.workers[key]
+		if !exists || !w.busy {
+			break
+		}
+		p.done.Wait()
+	}
+	p.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.mu.Lock()
+			delete(p.held, key)
+			p.mu.Unlock()
+			select {
+			case p.idle <- struct{}{}:
+			default:
+			}
+		})
+	}
+}
+
+// Idle 워커가 작업을 끝낼 때마다 신호를 받는 채널
+func (p *Pool) Idle() <-chan struct{} {
+	return p.idle
+}
+
+// Close 새 작업 수신을 멈추고 실행 중인 작업이 끝날 때까지 대기
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	for _, w := range p.workers {
+		close(w.inbox)
+	}
+	p.mu.Unlock()
+	p.wg.Wait()
+}